@@ -3,14 +3,11 @@ package chord
 import (
 	"fmt"
 	"regexp"
-	"strconv"
+	"strings"
 
 	"github.com/bayashi/go-music-chord-note/note"
 )
 
-// The number of supported chord
-const allChord = 75
-
 //     1   3       6   8   10
 // |  | | | |  |  | | | | | |  |
 // |  |_| |_|  |  |_| |_| |_|  |
@@ -76,25 +73,30 @@ var allKindOfChords = map[string][]int{
 	"add9":      {0, 4, 7, 14},
 }
 
-func allChords() [allChord]string {
-	var list [allChord]string
-	i := 0
+func allChords() []string {
+	chordsMu.RLock()
+	defer chordsMu.RUnlock()
+
+	var list []string
 	for k := range allKindOfChords {
-		list[i] = k
-		i++
+		list = append(list, k)
 	}
 
 	return list
 }
 
-// All types of chord
-var AllChords = allChords()
+// Built-in kinds of chord. A registered kind with the same note numbers is an alias of the built-in one.
+var builtinKinds = func() map[string]bool {
+	kinds := map[string]bool{}
+	for k := range allKindOfChords {
+		kinds[k] = true
+	}
 
-var (
-	ErrorNotFoundChord = func(chordName string) error { return fmt.Errorf("Not found chord. `%s`", chordName) }
-	ErrorNotFoundChordKind = func(chordKind string) error { return fmt.Errorf("Not found chord Kind. `%s`", chordKind) }
-	ErrorNoteOutOfRange = func(chordName string) error { return fmt.Errorf("Note out of range. `%s`", chordName) }
-)
+	return kinds
+}()
+
+// All types of chord. It's updated by `RegisterChord()`, use `ListChords()` while registering concurrently.
+var AllChords = allChords()
 
 // Get a chord as a note number list `{0, 4, 7, 11}` from kind of chord `M7`.
 // NOTE: Note number is within 0 to 24, actually.
@@ -103,78 +105,116 @@ func GetChordAsNumberList(chordKind string) ([]int, error) {
 		chordKind = "base"
 	}
 
+	chordsMu.RLock()
 	chord, isExists := allKindOfChords[chordKind]
+	chordsMu.RUnlock()
 	if !isExists {
 		return nil, ErrorNotFoundChordKind(chordKind)
 	}
 
-	return chord, nil
+	// a copy, not to change the registered one
+	return append([]int(nil), chord...), nil
+}
+
+// Get a kind of chord `m7` from a note number list `{0, 3, 7, 10}`. It's the reverse of `GetChordAsNumberList()`.
+// The major triad is an empty kind, and an alias is not returned. i.e. `7b5` for `{0, 4, 6, 10}`, not `7(b5)`.
+func GetChordKind(numbers []int) (string, error) {
+	key := fmt.Sprint(numbers)
+
+	chordsMu.RLock()
+	kind := ""
+	for k, n := range allKindOfChords {
+		if fmt.Sprint(n) == key && (kind == "" || preferKind(k, kind)) {
+			kind = k
+		}
+	}
+	chordsMu.RUnlock()
+
+	if kind == "" {
+		return "", ErrorNotFoundChordKind(key)
+	}
+
+	return KindName(kind), nil
 }
 
 // Get a chord as a note list `{"C", "E", "G", "B"}` from full chord name `CM7`.
+// Slash chord `C/E` is also available. The bass note comes first `{"E", "G", "C"}`.
 func GetChord(chordName string) ([]string, error) {
-	scalic, chordNumbers, err := parseChordName(chordName)
+	c, err := Parse(chordName)
 	if err != nil {
 		return nil, err
 	}
 
-	var notes []string
-	for _, n := range chordNumbers {
-		noteNumber := (n + scalic) % 12
-		notes = append(notes, note.BaseTones[noteNumber])
-	}
-
-	return notes, nil
+	return c.Notes(), nil
 }
 
 // get a base note number `0` and a note number list `{0, 4, 7, 11}` from full chord name `CM7`.
+// On a slash chord, the bass comes first. `C/E` -> `{4, 7, 0}`, `C/D` -> `{2, 0, 4, 7}`
 func parseChordName(chordName string) (int, []int, error) {
-	tonic, kind, err := splitChord(chordName)
+	c, err := Parse(chordName)
 	if err != nil {
 		return note.ErrorInt, nil, err
 	}
 
-	scalic, _ := note.NoteNumber(tonic)
-	chordNumbers, err2 := GetChordAsNumberList(kind);
-	if  err2 != nil {
-		return note.ErrorInt, nil, err2
+	return c.RootPitchClass, c.NumberList(), nil
+}
+
+var regexpSplitChord = regexp.MustCompile("^([A-G][b#]?)([^/]*)(?:/(.*))?$")
+
+var regexpBassNote = regexp.MustCompile("^[A-G][b#]?$")
+
+// split full chord name `Am7/G` to note name `A`, kind of chord `m7` and bass note `G`. Bass note is empty if it's not a slash chord.
+// Errors have the position of the wrong part in the chord name.
+func splitChord(chordName string) (string, string, string, error) {
+	re := regexpSplitChord.FindStringSubmatch(chordName)
+	if re == nil {
+		return "", "", "", ErrorNotFoundChord(chordName)
 	}
 
-	return scalic, chordNumbers, err
+	if strings.Contains(chordName, "/") && !regexpBassNote.MatchString(re[3]) {
+		return "", "", "", note.NewParseError(re[3], strings.Index(chordName, "/")+1, ErrNotFoundBassNote)
+	}
+
+	if _, err := GetChordAsNumberList(re[2]); err != nil {
+		return "", "", "", note.NewParseError(re[2], len(re[1]), ErrNotFoundKind)
+	}
+
+	return re[1], re[2], re[3], nil
 }
 
-var regexpSplitChord = regexp.MustCompile("^([A-G][b#]?)(.*)$")
+// get an index of the bass `4` in a note number list `{0, 4, 7}`. It's -1 if the bass is not a chord tone.
+func bassIndex(chordNumbers []int, bass int) int {
+	for i, n := range chordNumbers {
+		if n % 12 == bass {
+			return i
+		}
+	}
+
+	return -1
+}
 
-// split full chord name `CM7` to note name `C` and kind of chord `M7`.
-func splitChord(chordName string) (string, string, error) {
-	if re := regexpSplitChord.FindStringSubmatch(chordName); re != nil {
-		return re[1], re[2], nil
+// put the bass `4` first on a note number list `{0, 4, 7}` -> `{4, 7, 0}` as an inversion.
+// If the bass is not a chord tone, it's just added at first. `2`, `{0, 4, 7}` -> `{2, 0, 4, 7}`
+func withBass(chordNumbers []int, bass int) []int {
+	i := bassIndex(chordNumbers, bass)
+	if i < 0 {
+		return append([]int{bass}, chordNumbers...)
 	}
 
-	return "", "", ErrorNotFoundChord(chordName)
+	var list []int
+	list = append(list, chordNumbers[i:]...)
+	list = append(list, chordNumbers[:i]...)
+
+	return list
 }
 
 // Get a note list `{"F4", "A4", "C#5", "E5"}` from full chord name `FM7` and octave number `4`.
+// On a slash chord `C/E`, the bass note is the lowest `{"E4", "G4", "C5"}`.
 func GetChordWithOctave(chordName string, octave int) ([]string, error) {
-	noteList, err := GetChord(chordName)
+	c, err := Parse(chordName)
 	if err != nil {
 		return nil, err
 	}
 
-	var notesWithOctave []string
-	var lastPosition = -1
-	for _, n := range noteList {
-		position, _ := note.NoteNumber(n)
-		if position < lastPosition {
-			octave++
-		}
-		nn := n + strconv.Itoa(octave)
-		if octave > 9 || (octave == 9 && position > 7) {
-			return nil, ErrorNoteOutOfRange(nn)
-		}
-		notesWithOctave = append(notesWithOctave, nn)
-		lastPosition = position
-	}
-
-	return notesWithOctave, nil
+	return c.WithOctave(octave)
 }
@@ -1,6 +1,7 @@
 package chord
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/bayashi/actually"
@@ -79,6 +80,33 @@ func TestGetChordAsNumberListError(t *testing.T) {
 	}
 }
 
+func TestGetChordKind(t *testing.T) {
+	tests := []struct {
+		numbers []int
+		want    string
+	}{
+		{numbers: []int{0, 4, 7}, want: ""},
+		{numbers: []int{0, 3, 7, 10}, want: "m7"},
+		{numbers: []int{0, 4, 6, 10}, want: "7b5"},
+		{numbers: []int{0, 3, 6, 9}, want: "dim7"},
+		{numbers: []int{0, 5, 7}, want: "sus"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := GetChordKind(test.numbers)
+			actually.Got(err).FailNow().Nil(t)
+			if actual != test.want {
+				t.Errorf(`GetChordKind(%v), actual:"%v", want:"%v"`, test.numbers, actual, test.want)
+			}
+		})
+	}
+
+	if _, err := GetChordKind([]int{0, 4, 8, 12}); err == nil || err.Error() != ErrorNotFoundChordKind("[0 4 8 12]").Error() {
+		t.Errorf(`GetChordKind({0, 4, 8, 12}) wants Error(%v). but got "%v"`, ErrorNotFoundChordKind("[0 4 8 12]"), err)
+	}
+}
+
 func TestGetChord(t *testing.T) {
 	tests := []struct {
 		name string
@@ -104,6 +132,18 @@ func TestGetChord(t *testing.T) {
 			name: "C13",
 			want: []string{"C", "E", "G", "A#", "D", "F", "A"},
 		},
+		{
+			name: "C/E",
+			want: []string{"E", "G", "C"},
+		},
+		{
+			name: "Am7/G",
+			want: []string{"G", "A", "C", "E"},
+		},
+		{
+			name: "C/D",
+			want: []string{"D", "C", "E", "G"},
+		},
 	}
 
 	for _, test := range tests {
@@ -135,6 +175,14 @@ func TestGetChordError(t *testing.T) {
 			name: "CN7",
 			want: ErrorNotFoundChordKind("N7"),
 		},
+		{
+			name: "C/X",
+			want: ErrorNotFoundBassNote("X"),
+		},
+		{
+			name: "CM7/",
+			want: ErrorNotFoundBassNote(""),
+		},
 	}
 
 	for _, test := range tests {
@@ -177,6 +225,16 @@ func TestGetChordWithOctave(t *testing.T) {
 			octave: 9,
 			want: []string{"C9", "E9", "G9"},
 		},
+		{
+			name: "C/E",
+			octave: 4,
+			want: []string{"E4", "G4", "C5"},
+		},
+		{
+			name: "C/D",
+			octave: 3,
+			want: []string{"D3", "C4", "E4", "G4"},
+		},
 	}
 
 	for _, test := range tests {
@@ -228,3 +286,46 @@ func TestGetChordWithOctaveError(t *testing.T) {
 	}
 }
 
+func TestParseChordName(t *testing.T) {
+	tests := []struct {
+		name   string
+		scalic int
+		want   []int
+	}{
+		{
+			name:   "CM7",
+			scalic: 0,
+			want:   []int{0, 4, 7, 11},
+		},
+		{
+			name:   "Am7/G",
+			scalic: 9,
+			want:   []int{10, 0, 3, 7},
+		},
+		{
+			name:   "G7/F",
+			scalic: 7,
+			want:   []int{10, 0, 4, 7},
+		},
+		{
+			name:   "F/G",
+			scalic: 5,
+			want:   []int{2, 0, 4, 7},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scalic, actual, err := parseChordName(test.name)
+			actually.Got(err).FailNow().Nil(t)
+			if scalic != test.scalic || !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`parseChordName("%v"), actual:"%v, %v", want:"%v, %v"`, test.name, scalic, actual, test.scalic, test.want)
+			}
+		})
+	}
+
+	// slash chord must not touch the chord table
+	if m7 := allKindOfChords["m7"]; !reflect.DeepEqual(m7, []int{0, 3, 7, 10}) {
+		t.Errorf(`allKindOfChords["m7"] is broken. "%v"`, m7)
+	}
+}
@@ -0,0 +1,35 @@
+package chord
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Sentinel errors. Every error from this package wraps one of them, or an error of `note`.
+// So `errors.Is(err, chord.ErrNotFoundKind)` works, and `errors.As` gets a `*note.ParseError` on a wrong chord name.
+var (
+	ErrNotFound         = errors.New("Not found chord")
+	ErrNotFoundKind     = errors.New("Not found chord Kind")
+	ErrNotFoundBassNote = errors.New("Not found bass note")
+	ErrNoteOutOfRange   = errors.New("Note out of range")
+	ErrNotEnoughNotes   = errors.New("Need 2 notes at least to identify a chord.")
+	ErrCouldNotIdentify = errors.New("Could not identify chord")
+	ErrInvalidKind      = errors.New("Invalid kind of chord")
+	ErrDuplicateKind    = errors.New("Duplicate kind of chord")
+)
+
+// Errors with the offending input. They are kept as functions for compatibility.
+var (
+	ErrorNotFoundChord         = func(chordName string) error { return note.NewParseError(chordName, 0, ErrNotFound) }
+	ErrorNotFoundChordKind     = func(chordKind string) error { return note.NewParseError(chordKind, 0, ErrNotFoundKind) }
+	ErrorNoteOutOfRange        = func(chordName string) error { return fmt.Errorf("%w. `%s`", ErrNoteOutOfRange, chordName) }
+	ErrorNotFoundBassNote      = func(bassNote string) error { return note.NewParseError(bassNote, 0, ErrNotFoundBassNote) }
+	ErrorNotEnoughNotes        = ErrNotEnoughNotes
+	ErrorCouldNotIdentifyChord = func(notes string) error { return fmt.Errorf("%w. `%s`", ErrCouldNotIdentify, notes) }
+	ErrorInvalidKind           = func(chordKind string, reason string) error {
+		return fmt.Errorf("%w. `%s`, %s", ErrInvalidKind, chordKind, reason)
+	}
+	ErrorDuplicateKind = func(chordKind string) error { return fmt.Errorf("%w. `%s`", ErrDuplicateKind, chordKind) }
+)
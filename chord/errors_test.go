@@ -0,0 +1,62 @@
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestErrorsIs(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "X", err: func() error { _, err := GetChord("X"); return err }(), want: ErrNotFound},
+		{name: "CN7", err: func() error { _, err := GetChord("CN7"); return err }(), want: ErrNotFoundKind},
+		{name: "C/X", err: func() error { _, err := GetChord("C/X"); return err }(), want: ErrNotFoundBassNote},
+		{name: "A9", err: func() error { _, err := GetChordWithOctave("A", 9); return err }(), want: ErrNoteOutOfRange},
+		{name: "kind", err: func() error { _, err := GetChordAsNumberList("N7"); return err }(), want: ErrNotFoundKind},
+		{name: "identify", err: func() error { _, err := IdentifyChord([]string{"C"}); return err }(), want: ErrNotEnoughNotes},
+		{name: "identify note", err: func() error { _, err := IdentifyChord([]string{"C", "X"}); return err }(), want: note.ErrNotFound},
+		{name: "identify chord", err: func() error { _, err := IdentifyChord([]string{"C", "C#", "D"}); return err }(), want: ErrCouldNotIdentify},
+		{name: "transpose key", err: func() error { _, err := TransposeToKey("C", "C", "X"); return err }(), want: note.ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !errors.Is(test.err, test.want) {
+				t.Errorf(`errors.Is(%v, %v) should be true`, test.err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pos   int
+	}{
+		{name: "Xm7", input: "Xm7", pos: 0},
+		{name: "CN7", input: "N7", pos: 1},
+		{name: "EbN7", input: "N7", pos: 2},
+		{name: "Am7/X", input: "X", pos: 4},
+		{name: "Bb/", input: "", pos: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.name)
+
+			var pe *note.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf(`errors.As(%v) should get *note.ParseError`, err)
+			}
+			if pe.Input != test.input || pe.Pos != test.pos {
+				t.Errorf(`Parse("%v") got wrong ParseError "%+v". want Input:"%v", Pos:%v`, test.name, pe, test.input, test.pos)
+			}
+		})
+	}
+}
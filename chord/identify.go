@@ -0,0 +1,236 @@
+package chord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// A candidate of chord identified from notes
+type Candidate struct {
+	// Full chord name `Am7/G`. It's available for `GetChord`.
+	Name string
+	// Root note name `A`
+	Root string
+	// Kind of chord `m7`. It's a key of the chord table, `base` is for the major triad.
+	Kind string
+	// Bass note name `G`. It's empty on the root position.
+	Bass string
+	// 1.0 is a perfect match. It gets lower by each compromise.
+	Score float64
+	// Why the candidate matched, and what was compromised
+	Reasons []string
+}
+
+const (
+	scoreMissingFifth = 0.1
+	scoreInversion    = 0.05
+	scoreNonChordBass = 0.2
+)
+
+// Identify chords from note names `{"E", "G", "C"}`. The first note is treated as the bass.
+// Note name with octave `C4` is also available. Candidates are ranked by the score.
+func IdentifyChord(noteNames []string) ([]Candidate, error) {
+	var noteNumbers []int
+	spellings := map[int]string{}
+	for _, n := range noteNames {
+		noteNumber, err := note.NoteNumber(n)
+		if err != nil {
+			return nil, err
+		}
+		noteNumbers = append(noteNumbers, noteNumber)
+
+		name := strings.TrimRight(n, "-0123456789")
+		if _, isExists := spellings[noteNumber%12]; !isExists && regexpBassNote.MatchString(name) {
+			spellings[noteNumber%12] = name
+		}
+	}
+
+	if len(noteNumbers) < 2 {
+		return nil, ErrorNotEnoughNotes
+	}
+
+	candidates := identify(noteNumbers, noteNumbers[0]%12, spellings)
+	if len(candidates) == 0 {
+		return nil, ErrorCouldNotIdentifyChord(strings.Join(noteNames, " "))
+	}
+
+	return candidates, nil
+}
+
+// Identify chords from note numbers `{64, 67, 72}` i.e. MIDI input. The lowest note is treated as the bass.
+func IdentifyChordFromNumbers(noteNumbers []int) ([]Candidate, error) {
+	if len(noteNumbers) < 2 {
+		return nil, ErrorNotEnoughNotes
+	}
+
+	bass := noteNumbers[0]
+	for _, n := range noteNumbers {
+		if n < note.MinimumNoteNumber || n > note.MaximumNoteNumber {
+			return nil, note.ErrorOutOfRange
+		}
+		if n < bass {
+			bass = n
+		}
+	}
+
+	candidates := identify(noteNumbers, bass%12, map[int]string{})
+	if len(candidates) == 0 {
+		return nil, ErrorCouldNotIdentifyChord(fmt.Sprint(noteNumbers))
+	}
+
+	return candidates, nil
+}
+
+// match every root and every kind of chord against the pitch classes.
+func identify(noteNumbers []int, bass int, spellings map[int]string) []Candidate {
+	pitchClasses := map[int]bool{}
+	for _, n := range noteNumbers {
+		pitchClasses[n%12] = true
+	}
+
+	var candidates []Candidate
+	for _, kind := range canonicalKinds() {
+		intervals, _ := GetChordAsNumberList(kind)
+		for root := 0; root < 12; root++ {
+			if c, ok := matchChord(pitchClasses, bass, root, kind, intervals); ok {
+				c.Root = spell(root, spellings)
+				c.Name = c.Root + KindName(kind)
+				if bass != root {
+					c.Bass = spell(bass, spellings)
+					c.Name += "/" + c.Bass
+				}
+				candidates = append(candidates, c)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	return candidates
+}
+
+// check a chord `root` + `kind` covers the pitch classes. Only the 5th can be missing, and only the bass can be a non-chord tone.
+func matchChord(pitchClasses map[int]bool, bass int, root int, kind string, intervals []int) (Candidate, bool) {
+	c := Candidate{Kind: kind, Score: 1.0}
+
+	if !pitchClasses[root] {
+		return c, false
+	}
+
+	tones := map[int]bool{}
+	for _, n := range intervals {
+		interval := n % 12
+		tones[interval] = true
+		if pitchClasses[(root+interval)%12] {
+			continue
+		}
+		if interval != 7 {
+			return c, false
+		}
+		c.Score -= scoreMissingFifth
+		c.Reasons = append(c.Reasons, "missing 5th")
+	}
+
+	for pc := range pitchClasses {
+		if !tones[(pc-root+12)%12] && pc != bass {
+			return c, false
+		}
+	}
+
+	switch {
+	case bass == root:
+		c.Reasons = append([]string{"root position"}, c.Reasons...)
+	case tones[(bass-root+12)%12]:
+		c.Score -= scoreInversion
+		c.Reasons = append([]string{"inversion"}, c.Reasons...)
+	default:
+		c.Score -= scoreNonChordBass
+		c.Reasons = append([]string{"non-chord bass"}, c.Reasons...)
+	}
+
+	return c, true
+}
+
+// get a note name of a pitch class from the given spellings, or `BaseTones`.
+func spell(pitchClass int, spellings map[int]string) string {
+	if name, isExists := spellings[pitchClass]; isExists {
+		return name
+	}
+
+	return note.BaseTones[pitchClass]
+}
+
+// Get a kind of chord as in a full chord name. `base` is the major triad, and it's an empty kind.
+func KindName(kind string) string {
+	if kind == "base" {
+		return ""
+	}
+
+	return kind
+}
+
+// Get kinds of chord without aliases. i.e. `7b5` is kept, and `7(b5)`, `7(-5)` and `7-5` are dropped.
+func canonicalKinds() []string {
+	chordsMu.RLock()
+	defer chordsMu.RUnlock()
+
+	canonical := map[string]string{}
+	for kind, numbers := range allKindOfChords {
+		key := fmt.Sprint(numbers)
+		if current, isExists := canonical[key]; !isExists || preferKind(kind, current) {
+			canonical[key] = kind
+		}
+	}
+
+	var kinds []string
+	for _, kind := range canonical {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// Get the canonical kind of chord from an alias. i.e. `7(b5)` -> `7b5`, `dim6` -> `dim7`
+func canonicalKind(kind string) string {
+	chordsMu.RLock()
+	defer chordsMu.RUnlock()
+
+	numbers, isExists := allKindOfChords[kind]
+	if !isExists {
+		return kind
+	}
+
+	key := fmt.Sprint(numbers)
+	canonical := kind
+	for k, n := range allKindOfChords {
+		if fmt.Sprint(n) == key && preferKind(k, canonical) {
+			canonical = k
+		}
+	}
+
+	return canonical
+}
+
+// Prefer a built-in kind of chord over a registered one, then a name without `-` and parentheses, then shorter one.
+func preferKind(a string, b string) bool {
+	if ab, bb := builtinKinds[a], builtinKinds[b]; ab != bb {
+		return ab
+	}
+	if ac, bc := strings.ContainsAny(a, "-("), strings.ContainsAny(b, "-("); ac != bc {
+		return !ac
+	}
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	return a > b
+}
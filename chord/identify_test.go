@@ -0,0 +1,158 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/bayashi/actually"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestIdentifyChord(t *testing.T) {
+	tests := []struct {
+		notes  []string
+		want   string
+		second string
+	}{
+		{
+			notes: []string{"C", "E", "G"},
+			want:  "C",
+		},
+		{
+			notes: []string{"E", "G", "C"},
+			want:  "C/E",
+		},
+		{
+			notes:  []string{"C", "E", "G", "A"},
+			want:   "C6",
+			second: "Am7/C",
+		},
+		{
+			notes:  []string{"A", "C", "E", "G"},
+			want:   "Am7",
+			second: "C6/A",
+		},
+		{
+			notes: []string{"C", "E", "A#"},
+			want:  "C7",
+		},
+		{
+			notes: []string{"Bb", "D", "F", "A"},
+			want:  "BbM7",
+		},
+		{
+			notes: []string{"G3", "B3", "D4", "F4", "A4"},
+			want:  "G9",
+		},
+		{
+			notes: []string{"F#", "C", "E", "G"},
+			want:  "C/F#",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := IdentifyChord(test.notes)
+			actually.Got(err).FailNow().Nil(t)
+			if actual[0].Name != test.want {
+				t.Errorf(`IdentifyChord(%v), actual:"%v", want:"%v"`, test.notes, actual[0].Name, test.want)
+			}
+			if test.second != "" && actual[1].Name != test.second {
+				t.Errorf(`IdentifyChord(%v), 2nd candidate actual:"%v", want:"%v"`, test.notes, actual[1].Name, test.second)
+			}
+			if _, err := GetChord(actual[0].Name); err != nil {
+				t.Errorf(`IdentifyChord(%v) gives a wrong chord name "%v". %v`, test.notes, actual[0].Name, err)
+			}
+		})
+	}
+}
+
+func TestIdentifyChordReasons(t *testing.T) {
+	actual, err := IdentifyChord([]string{"E", "C", "A#"})
+	actually.Got(err).FailNow().Nil(t)
+
+	c := actual[0]
+	if c.Name != "C7/E" || c.Root != "C" || c.Kind != "7" || c.Bass != "E" {
+		t.Errorf(`IdentifyChord() got wrong candidate "%+v"`, c)
+	}
+	if c.Score != 1.0-scoreInversion-scoreMissingFifth {
+		t.Errorf(`IdentifyChord() got wrong score "%v"`, c.Score)
+	}
+	if len(c.Reasons) != 2 || c.Reasons[0] != "inversion" || c.Reasons[1] != "missing 5th" {
+		t.Errorf(`IdentifyChord() got wrong reasons "%v"`, c.Reasons)
+	}
+}
+
+func TestIdentifyChordError(t *testing.T) {
+	tests := []struct {
+		notes []string
+		want  error
+	}{
+		{
+			notes: []string{"C"},
+			want:  ErrorNotEnoughNotes,
+		},
+		{
+			notes: []string{"C", "X"},
+			want:  note.ErrorNotFoundNote("X"),
+		},
+		{
+			notes: []string{"C", "C#", "D"},
+			want:  ErrorCouldNotIdentifyChord("C C# D"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want.Error(), func(t *testing.T) {
+			got, err := IdentifyChord(test.notes)
+			actually.Got(err).FailNow().NotNil(t)
+			if len(got) != 0 {
+				t.Errorf(`IdentifyChord(%v) wants empty result. But got (%v).`, test.notes, got)
+			}
+			if err.Error() != test.want.Error() {
+				t.Errorf(`IdentifyChord(%v) wants Error(%v). but it's wrong. "%v"`, test.notes, test.want, err)
+			}
+		})
+	}
+}
+
+func TestIdentifyChordFromNumbers(t *testing.T) {
+	tests := []struct {
+		numbers []int
+		want    string
+	}{
+		{
+			numbers: []int{72, 64, 67},
+			want:    "C/E",
+		},
+		{
+			numbers: []int{62, 65, 69, 72},
+			want:    "Dm7",
+		},
+		{
+			numbers: []int{60, 63, 66, 69},
+			want:    "Cdim7",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := IdentifyChordFromNumbers(test.numbers)
+			actually.Got(err).FailNow().Nil(t)
+			if actual[0].Name != test.want {
+				t.Errorf(`IdentifyChordFromNumbers(%v), actual:"%v", want:"%v"`, test.numbers, actual[0].Name, test.want)
+			}
+		})
+	}
+
+	if _, err := IdentifyChordFromNumbers([]int{60, 128}); err != note.ErrorOutOfRange {
+		t.Errorf(`IdentifyChordFromNumbers() wants Error(%v). but it's wrong. "%v"`, note.ErrorOutOfRange, err)
+	}
+}
+
+func TestKindName(t *testing.T) {
+	for kind, want := range map[string]string{"base": "", "m7": "m7", "": ""} {
+		if actual := KindName(kind); actual != want {
+			t.Errorf(`KindName(%q), actual:"%v", want:"%v"`, kind, actual, want)
+		}
+	}
+}
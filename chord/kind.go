@@ -0,0 +1,147 @@
+package chord
+
+// Quality of a kind of chord
+type Quality string
+
+const (
+	Major      Quality = "major"
+	Minor      Quality = "minor"
+	Dominant   Quality = "dominant"
+	Diminished Quality = "diminished"
+	// The minor 3rd, the diminished 5th and the minor 7th `m7b5`
+	HalfDiminished Quality = "half-diminished"
+	Augmented      Quality = "augmented"
+	Suspended      Quality = "suspended"
+)
+
+// Structured view of a kind of chord
+type KindInfo struct {
+	// Canonical kind of chord `7b9`. `base` is for the major triad.
+	Kind string
+	// `dominant` for `7b9`
+	Quality Quality
+	// Intervals of the chord tones, the root, the 3rd, the 5th, the 6th and the 7th `{0, 4, 7, 10}`
+	ChordTones []int
+	// Intervals of the tensions in the kind of chord `{13}`. `add9` and `madd4` have tensions within an octave `{2}`, `{5}`.
+	Tensions []int
+	// Interval of the seventh `10`. It's implied by the quality on a chord without the seventh i.e. `11` for `6`, `9` for `dim`.
+	Seventh int
+	// Whether the seventh is implied, not in the chord
+	IsSeventhImplied bool
+	// Intervals of tensions available by the quality, except ones already in the chord, on the pitch class of a chord tone,
+	// or a minor 9th above a chord tone except on `dominant` `{14, 18, 21, 13, 15, 20}`
+	AvailableTensions []int
+}
+
+// Names of tensions as in chord names, by interval
+var tensionNames = map[int]string{
+	13: "b9", 14: "9", 15: "#9",
+	17: "11", 18: "#11",
+	20: "b13", 21: "13",
+}
+
+// Tensions available by quality, over the implied seventh. i.e. `7` -> `9, #11, 13, b9, #9, b13`
+var availableTensions = map[Quality][]int{
+	Major:          {14, 18, 21},
+	Minor:          {14, 17, 21},
+	Dominant:       {14, 18, 21, 13, 15, 20},
+	Diminished:     {14, 17, 20},
+	HalfDiminished: {14, 17, 20},
+	Augmented:      {14, 18},
+	Suspended:      {14, 21},
+}
+
+// Get the structured view of a kind of chord `7b9`. An alias is resolved to its canonical kind.
+// The quality is decided by the 3rd, the 5th and the 7th within an octave, and `aug7` is `dominant`, `m7b5` is `half-diminished`.
+// Available tensions are by rule of the quality, except ones already in the chord i.e. `9` on `add9`,
+// ones on the pitch class of a chord tone i.e. `#11` on `7b5`, `b13` on `aug7`, `9` on `sus2`, and ones a minor 9th above a chord tone i.e. `13` on `-6`.
+// `dominant` keeps `b9` and `b13` as altered tensions, though they are a minor 9th above the root and the 5th.
+func GetKindInfo(chordKind string) (KindInfo, error) {
+	numbers, err := GetChordAsNumberList(chordKind)
+	if err != nil {
+		return KindInfo{}, err
+	}
+	if chordKind == "" {
+		chordKind = "base"
+	}
+
+	var within, present [12]bool
+	for _, n := range numbers {
+		if n < 12 {
+			within[n] = true
+		}
+		present[n%12] = true
+	}
+	hasThird := within[3] || within[4]
+
+	info := KindInfo{Kind: canonicalKind(chordKind), Quality: qualityOf(within)}
+	for _, n := range numbers {
+		if n >= 12 || hasThird && (n == 2 || n == 5) {
+			info.Tensions = append(info.Tensions, n)
+			continue
+		}
+		info.ChordTones = append(info.ChordTones, n)
+	}
+
+	info.Seventh, info.IsSeventhImplied = seventhOf(within, info.Quality)
+
+	var chordTones [12]bool
+	for _, n := range info.ChordTones {
+		chordTones[n] = true
+	}
+	for _, n := range availableTensions[info.Quality] {
+		if present[n-12] || info.Quality != Dominant && chordTones[(n-1)%12] {
+			continue
+		}
+		info.AvailableTensions = append(info.AvailableTensions, n)
+	}
+
+	return info, nil
+}
+
+// Get a name of a tension as in chord names `b9` from an interval `13`. An interval within an octave is named as its tension an octave above `2` -> `9`.
+// It's empty if the interval is not a tension.
+func TensionName(interval int) string {
+	if interval >= 0 && interval < 12 {
+		interval += 12
+	}
+
+	return tensionNames[interval]
+}
+
+func qualityOf(within [12]bool) Quality {
+	switch {
+	case within[4] && within[10]:
+		return Dominant
+	case within[4] && within[8] && !within[7]:
+		return Augmented
+	case within[4]:
+		return Major
+	case within[3] && within[6] && !within[7] && within[10]:
+		return HalfDiminished
+	case within[3] && within[6] && !within[7]:
+		return Diminished
+	case within[3]:
+		return Minor
+	}
+
+	return Suspended
+}
+
+// get the seventh in the chord, or the implied one by the quality
+func seventhOf(within [12]bool, quality Quality) (int, bool) {
+	switch {
+	case within[10]:
+		return 10, false
+	case within[11]:
+		return 11, false
+	case quality == Diminished && within[9]:
+		return 9, false
+	case quality == Diminished:
+		return 9, true
+	case quality == Major:
+		return 11, true
+	}
+
+	return 10, true
+}
@@ -0,0 +1,111 @@
+package chord
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/actually"
+)
+
+func TestGetKindInfo(t *testing.T) {
+	tests := []struct {
+		kind string
+		want KindInfo
+	}{
+		{
+			kind: "",
+			want: KindInfo{Kind: "base", Quality: Major, ChordTones: []int{0, 4, 7}, Seventh: 11, IsSeventhImplied: true, AvailableTensions: []int{14, 18, 21}},
+		},
+		{
+			kind: "7",
+			want: KindInfo{Kind: "7", Quality: Dominant, ChordTones: []int{0, 4, 7, 10}, Seventh: 10, AvailableTensions: []int{14, 18, 21, 13, 15, 20}},
+		},
+		{
+			kind: "7(b9, 13)",
+			want: KindInfo{Kind: "7(b9, 13)", Quality: Dominant, ChordTones: []int{0, 4, 7, 10}, Tensions: []int{13, 21}, Seventh: 10, AvailableTensions: []int{14, 18, 15, 20}},
+		},
+		{
+			kind: "aug7",
+			want: KindInfo{Kind: "aug7", Quality: Dominant, ChordTones: []int{0, 4, 8, 10}, Seventh: 10, AvailableTensions: []int{14, 18, 21, 13, 15}},
+		},
+		{
+			kind: "6",
+			want: KindInfo{Kind: "6", Quality: Major, ChordTones: []int{0, 4, 7, 9}, Seventh: 11, IsSeventhImplied: true, AvailableTensions: []int{14, 18}},
+		},
+		{
+			kind: "add9",
+			want: KindInfo{Kind: "add9", Quality: Major, ChordTones: []int{0, 4, 7}, Tensions: []int{14}, Seventh: 11, IsSeventhImplied: true, AvailableTensions: []int{18, 21}},
+		},
+		{
+			kind: "madd4",
+			want: KindInfo{Kind: "madd4", Quality: Minor, ChordTones: []int{0, 3, 7}, Tensions: []int{5}, Seventh: 10, IsSeventhImplied: true, AvailableTensions: []int{14, 21}},
+		},
+		{
+			kind: "m11",
+			want: KindInfo{Kind: "m11", Quality: Minor, ChordTones: []int{0, 3, 7, 10}, Tensions: []int{14, 17}, Seventh: 10, AvailableTensions: []int{21}},
+		},
+		{
+			kind: "m7(b5)",
+			want: KindInfo{Kind: "m7b5", Quality: HalfDiminished, ChordTones: []int{0, 3, 6, 10}, Seventh: 10, AvailableTensions: []int{14, 17, 20}},
+		},
+		{
+			kind: "dim",
+			want: KindInfo{Kind: "dim", Quality: Diminished, ChordTones: []int{0, 3, 6}, Seventh: 9, IsSeventhImplied: true, AvailableTensions: []int{14, 17, 20}},
+		},
+		{
+			kind: "7(#11)",
+			want: KindInfo{Kind: "7#11", Quality: Dominant, ChordTones: []int{0, 4, 7, 10}, Tensions: []int{15, 18}, Seventh: 10, AvailableTensions: []int{14, 21, 13, 20}},
+		},
+		{
+			kind: "dim6",
+			want: KindInfo{Kind: "dim7", Quality: Diminished, ChordTones: []int{0, 3, 6, 9}, Seventh: 9, AvailableTensions: []int{14, 17, 20}},
+		},
+		{
+			kind: "aug",
+			want: KindInfo{Kind: "aug", Quality: Augmented, ChordTones: []int{0, 4, 8}, Seventh: 10, IsSeventhImplied: true, AvailableTensions: []int{14, 18}},
+		},
+		{
+			kind: "sus2",
+			want: KindInfo{Kind: "sus2", Quality: Suspended, ChordTones: []int{0, 2, 7}, Seventh: 10, IsSeventhImplied: true, AvailableTensions: []int{21}},
+		},
+		{
+			kind: "7sus4",
+			want: KindInfo{Kind: "7sus4", Quality: Suspended, ChordTones: []int{0, 5, 7, 10}, Seventh: 10, AvailableTensions: []int{14, 21}},
+		},
+		{
+			// 13 is a minor 9th above b13
+			kind: "-6",
+			want: KindInfo{Kind: "-6", Quality: Major, ChordTones: []int{0, 4, 7, 8}, Seventh: 11, IsSeventhImplied: true, AvailableTensions: []int{14, 18}},
+		},
+		{
+			kind: "m7#5",
+			want: KindInfo{Kind: "m7#5", Quality: Minor, ChordTones: []int{0, 3, 8, 10}, Seventh: 10, AvailableTensions: []int{14, 17}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.kind, func(t *testing.T) {
+			actual, err := GetKindInfo(test.kind)
+			actually.Got(err).FailNow().Nil(t)
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`GetKindInfo("%v"), actual:"%+v", want:"%+v"`, test.kind, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestGetKindInfoError(t *testing.T) {
+	if _, err := GetKindInfo("N7"); !errors.Is(err, ErrNotFoundKind) {
+		t.Errorf(`GetKindInfo("N7"), actual error:%v`, err)
+	}
+}
+
+func TestTensionName(t *testing.T) {
+	tests := map[int]string{13: "b9", 14: "9", 15: "#9", 17: "11", 18: "#11", 20: "b13", 21: "13", 2: "9", 5: "11", 4: "", 10: "", 24: ""}
+	for interval, want := range tests {
+		if actual := TensionName(interval); actual != want {
+			t.Errorf(`TensionName(%v), actual:"%v", want:"%v"`, interval, actual, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package chord
+
+import (
+	"strings"
+	"sync"
+)
+
+// It guards `allKindOfChords` and `AllChords`
+var chordsMu sync.RWMutex
+
+// Register an original kind of chord `madd9` with a note number list `{0, 3, 7, 14}`, and its aliases `m(add9)`.
+// Note numbers should start from 0 and go up within 0 to 24. It's safe for concurrent use.
+// A kind with the same note numbers as a built-in kind, i.e. `M` for `{0, 4, 7}` of `base`, is an alias, and chord names keep the built-in kind.
+func RegisterChord(chordKind string, chordNumbers []int, aliases ...string) error {
+	names := append([]string{chordKind}, aliases...)
+	for _, name := range names {
+		if err := validateKindName(name); err != nil {
+			return err
+		}
+	}
+	if err := validateChordNumbers(chordKind, chordNumbers); err != nil {
+		return err
+	}
+
+	chordsMu.Lock()
+	defer chordsMu.Unlock()
+
+	seen := map[string]bool{}
+	for _, name := range names {
+		if _, isExists := allKindOfChords[name]; isExists || seen[name] {
+			return ErrorDuplicateKind(name)
+		}
+		seen[name] = true
+	}
+
+	numbers := append([]int{}, chordNumbers...)
+	for _, name := range names {
+		allKindOfChords[name] = numbers
+	}
+	// a new slice, so a slice which is got before is not changed
+	AllChords = append(append([]string{}, AllChords...), names...)
+
+	return nil
+}
+
+// Get all types of chord including registered ones. It's safe for concurrent use.
+func ListChords() []string {
+	return allChords()
+}
+
+// A kind of chord follows a root note in a chord name, so it can't start with an accidental or have a slash.
+func validateKindName(chordKind string) error {
+	switch {
+	case chordKind == "":
+		return ErrorInvalidKind(chordKind, "empty name")
+	case chordKind[0] == 'b' || chordKind[0] == '#':
+		return ErrorInvalidKind(chordKind, "starting with an accidental")
+	case strings.Contains(chordKind, "/"):
+		return ErrorInvalidKind(chordKind, "including a slash")
+	}
+
+	return nil
+}
+
+// Note numbers should start from 0, and go up within 0 to 24.
+func validateChordNumbers(chordKind string, chordNumbers []int) error {
+	if len(chordNumbers) == 0 || chordNumbers[0] != 0 {
+		return ErrorInvalidKind(chordKind, "note numbers should start from 0")
+	}
+	for i := 1; i < len(chordNumbers); i++ {
+		if chordNumbers[i] <= chordNumbers[i-1] || chordNumbers[i] > 24 {
+			return ErrorInvalidKind(chordKind, "note numbers should go up within 0 to 24")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/bayashi/actually"
+)
+
+// remove registered kinds of chord not to affect other tests
+func unregisterChords(t *testing.T, names ...string) {
+	t.Cleanup(func() {
+		chordsMu.Lock()
+		defer chordsMu.Unlock()
+		for _, name := range names {
+			delete(allKindOfChords, name)
+		}
+		var list []string
+		for k := range allKindOfChords {
+			list = append(list, k)
+		}
+		AllChords = list
+	})
+}
+
+func TestRegisterChord(t *testing.T) {
+	unregisterChords(t, "madd9", "m(add9)")
+
+	err := RegisterChord("madd9", []int{0, 3, 7, 14}, "m(add9)")
+	actually.Got(err).FailNow().Nil(t)
+
+	for _, name := range []string{"Cmadd9", "Cm(add9)"} {
+		actual, err := GetChord(name)
+		actually.Got(err).FailNow().Nil(t)
+		if !reflect.DeepEqual(actual, []string{"C", "D#", "G", "D"}) {
+			t.Errorf(`GetChord("%v"), actual:"%v"`, name, actual)
+		}
+	}
+
+	if spelled, _ := GetSpelledChord("Cmadd9"); !reflect.DeepEqual(spelled, []string{"C", "Eb", "G", "D"}) {
+		t.Errorf(`GetSpelledChord("Cmadd9"), actual:"%v"`, spelled)
+	}
+
+	if c, _ := IdentifyChord([]string{"A", "C", "E", "B"}); c[0].Name != "Amadd9" {
+		t.Errorf(`IdentifyChord() doesn't use registered chord. "%v"`, c[0].Name)
+	}
+
+	if len(AllChords) != 77 || len(ListChords()) != 77 {
+		t.Errorf(`AllChords doesn't reflect registrations. %v, %v`, len(AllChords), len(ListChords()))
+	}
+}
+
+func TestRegisterChordSameAsBuiltin(t *testing.T) {
+	unregisterChords(t, "M", "maj")
+
+	err := RegisterChord("M", []int{0, 4, 7}, "maj")
+	actually.Got(err).FailNow().Nil(t)
+
+	if actual, _ := GetChord("CM"); !reflect.DeepEqual(actual, []string{"C", "E", "G"}) {
+		t.Errorf(`GetChord("CM"), actual:"%v"`, actual)
+	}
+	if c, _ := Parse("C"); c.Kind != "base" || c.String() != "C" {
+		t.Errorf(`Parse("C"), actual kind:"%v", name:"%v"`, c.Kind, c.String())
+	}
+	if c, _ := Parse("Cmaj"); c.Kind != "base" || c.String() != "C" {
+		t.Errorf(`Parse("Cmaj"), actual kind:"%v", name:"%v"`, c.Kind, c.String())
+	}
+	if kind, _ := GetChordKind([]int{0, 4, 7}); kind != "" {
+		t.Errorf(`GetChordKind({0, 4, 7}), actual:"%v"`, kind)
+	}
+	if c, _ := IdentifyChord([]string{"C", "E", "G"}); c[0].Name != "C" {
+		t.Errorf(`IdentifyChord("C", "E", "G"), actual:"%v"`, c[0].Name)
+	}
+	if info, _ := GetKindInfo(""); info.Kind != "base" {
+		t.Errorf(`GetKindInfo(""), actual kind:"%v"`, info.Kind)
+	}
+}
+
+func TestRegisterChordError(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []int
+		aliases []string
+		want    error
+	}{
+		{name: "", numbers: []int{0, 4, 7}, want: ErrInvalidKind},
+		{name: "b9x", numbers: []int{0, 4, 7}, want: ErrInvalidKind},
+		{name: "#x", numbers: []int{0, 4, 7}, want: ErrInvalidKind},
+		{name: "x/y", numbers: []int{0, 4, 7}, want: ErrInvalidKind},
+		{name: "x", numbers: []int{}, want: ErrInvalidKind},
+		{name: "x", numbers: []int{1, 4, 7}, want: ErrInvalidKind},
+		{name: "x", numbers: []int{0, 7, 4}, want: ErrInvalidKind},
+		{name: "x", numbers: []int{0, 4, 25}, want: ErrInvalidKind},
+		{name: "m7", numbers: []int{0, 3, 7, 10}, want: ErrDuplicateKind},
+		{name: "x", numbers: []int{0, 4, 7}, aliases: []string{"m7"}, want: ErrDuplicateKind},
+		{name: "x", numbers: []int{0, 4, 7}, aliases: []string{"x"}, want: ErrDuplicateKind},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.name, test.numbers, test.aliases), func(t *testing.T) {
+			err := RegisterChord(test.name, test.numbers, test.aliases...)
+			if !errors.Is(err, test.want) {
+				t.Errorf(`RegisterChord("%v", %v, %v) wants Error(%v). but got "%v"`, test.name, test.numbers, test.aliases, test.want, err)
+			}
+		})
+	}
+
+	if _, isExists := allKindOfChords["x"]; isExists {
+		t.Error(`Failed registration should not register anything`)
+	}
+}
+
+func TestRegisterChordConcurrently(t *testing.T) {
+	var names []string
+	for i := 0; i < 20; i++ {
+		names = append(names, fmt.Sprintf("concurrent%d", i))
+	}
+	unregisterChords(t, names...)
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(2)
+		go func(name string) {
+			defer wg.Done()
+			if err := RegisterChord(name, []int{0, 4, 7}); err != nil {
+				t.Errorf(`RegisterChord("%v") got error: %v`, name, err)
+			}
+		}(name)
+		go func() {
+			defer wg.Done()
+			_, _ = GetChord("CM7")
+			_ = ListChords()
+		}()
+	}
+	wg.Wait()
+
+	for _, name := range names {
+		if _, err := GetChordAsNumberList(name); err != nil {
+			t.Errorf(`"%v" is not registered. %v`, name, err)
+		}
+	}
+}
+
+func TestRegisterChordCopy(t *testing.T) {
+	unregisterChords(t, "copied")
+
+	numbers := []int{0, 4, 7, 9, 14}
+	actually.Got(RegisterChord("copied", numbers)).FailNow().Nil(t)
+	numbers[1] = 3
+
+	got, _ := GetChordAsNumberList("copied")
+	got[1] = 3
+	if again, _ := GetChordAsNumberList("copied"); !reflect.DeepEqual(again, []int{0, 4, 7, 9, 14}) {
+		t.Errorf(`The registered kind should not be changed by callers, actual:"%v"`, again)
+	}
+}
@@ -0,0 +1,45 @@
+package chord
+
+// Degree of each interval within an octave. i.e. `3` -> the 3rd (minor 3rd), `10` -> the 7th (minor 7th)
+var intervalDegrees = [12]int{1, 2, 2, 3, 3, 4, 5, 5, 5, 6, 7, 7}
+
+// Degree of tensions over an octave. i.e. `15` -> the 9th (#9), `18` -> the 11th (#11)
+var tensionDegrees = map[int]int{
+	13: 2, 14: 2, 15: 2,
+	17: 4, 18: 4,
+	20: 6, 21: 6,
+}
+
+// Kinds of chord that spell some intervals differently from `intervalDegrees`
+var chordDegreeOverrides = map[string]map[int]int{
+	"-6":   {8: 6}, // b6, not #5
+	"dim7": {9: 7}, // bb7, not 6
+	"dim6": {9: 7},
+}
+
+// get a degree `3` from kind of chord `m7` and an interval `3`.
+func chordDegree(chordKind string, interval int) int {
+	if overrides, isExists := chordDegreeOverrides[chordKind]; isExists {
+		if degree, isExists := overrides[interval]; isExists {
+			return degree
+		}
+	}
+
+	if degree, isExists := tensionDegrees[interval]; isExists {
+		return degree
+	}
+
+	return intervalDegrees[interval%12]
+}
+
+// Get a chord as a correctly spelled note list `{"Eb", "G", "Bb", "D"}` from full chord name `EbM7`.
+// Each letter comes from the root letter and the degree of each interval, so `Cdim7` is `{"C", "Eb", "Gb", "Bbb"}`.
+// On a slash chord `Ab/C`, the bass note comes first `{"C", "Eb", "Ab"}`.
+func GetSpelledChord(chordName string) ([]string, error) {
+	c, err := Parse(chordName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SpelledNotes()
+}
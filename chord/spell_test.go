@@ -0,0 +1,107 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/bayashi/actually"
+)
+
+func TestGetSpelledChord(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{
+			name: "C",
+			want: []string{"C", "E", "G"},
+		},
+		{
+			name: "EbM7",
+			want: []string{"Eb", "G", "Bb", "D"},
+		},
+		{
+			name: "Gb",
+			want: []string{"Gb", "Bb", "Db"},
+		},
+		{
+			name: "Cdim7",
+			want: []string{"C", "Eb", "Gb", "Bbb"},
+		},
+		{
+			name: "BM7",
+			want: []string{"B", "D#", "F#", "A#"},
+		},
+		{
+			name: "C13",
+			want: []string{"C", "E", "G", "Bb", "D", "F", "A"},
+		},
+		{
+			name: "C7(#11)",
+			want: []string{"C", "E", "G", "Bb", "D#", "F#"},
+		},
+		{
+			name: "Caug",
+			want: []string{"C", "E", "G#"},
+		},
+		{
+			name: "C-6",
+			want: []string{"C", "E", "G", "Ab"},
+		},
+		{
+			name: "F#m7(b5)",
+			want: []string{"F#", "A", "C", "E"},
+		},
+		{
+			name: "Ab/C",
+			want: []string{"C", "Eb", "Ab"},
+		},
+		{
+			name: "C/Bb",
+			want: []string{"Bb", "C", "E", "G"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := GetSpelledChord(test.name)
+			actually.Got(err).FailNow().Nil(t)
+			if len(actual) != len(test.want) {
+				t.Errorf(`GetSpelledChord("%v"), actual:"%v", want:"%v"`, test.name, actual, test.want)
+			}
+			for i, v := range test.want {
+				if actual[i] != v {
+					t.Errorf(`GetSpelledChord("%v"), note No.%v is wrong. actual:"%v", want:"%v"`, test.name, i+1, actual, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSpelledChordError(t *testing.T) {
+	tests := []struct {
+		name string
+		want error
+	}{
+		{
+			name: "X",
+			want: ErrorNotFoundChord("X"),
+		},
+		{
+			name: "CN7",
+			want: ErrorNotFoundChordKind("N7"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetSpelledChord(test.name)
+			actually.Got(err).FailNow().NotNil(t)
+			if len(got) != 0 {
+				t.Errorf(`GetSpelledChord("%v") wants empty result. But got (%v).`, test.name, got)
+			}
+			if err.Error() != test.want.Error() {
+				t.Errorf(`GetSpelledChord("%v") wants Error(%v). but it's wrong. "%v"`, test.name, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package chord
+
+import (
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Transpose full chord name `Bb7(#9)` by semitones `3` -> `Db7(#9)`. Kind of chord is kept as written.
+// A flat root is spelled with flats, a sharp root with sharps, and a natural root as in the major key on the new root `Eb`, `F#`.
+// The bass of a slash chord keeps its spelling relative to the root. `C/E`, `1` -> `Db/F`
+func Transpose(chordName string, semitones int) (string, error) {
+	tonic, kind, bass, err := splitChord(chordName)
+	if err != nil {
+		return "", err
+	}
+
+	root, _ := note.Parse(tonic)
+	pitchClass := ((root.PitchClass()+semitones)%12 + 12) % 12
+	newRoot := note.BaseTones[pitchClass]
+	if root.Accidental < 0 || (root.Accidental == 0 && isFlatPitchClass(pitchClass)) {
+		newRoot = note.FlatTones[pitchClass]
+	}
+
+	return transposeChord(tonic, newRoot, kind, bass)
+}
+
+// Transpose full chord name `Bb7(#9)` from a key `Bb` to a key `D` -> `D7(#9)`.
+// The root keeps its spelling relative to the key, so `Eb` in `Bb` goes to `G` in `D`. The key `m` at the end is for minor `Gm`.
+func TransposeToKey(chordName string, fromKey string, toKey string) (string, error) {
+	tonic, kind, bass, err := splitChord(chordName)
+	if err != nil {
+		return "", err
+	}
+
+	root, _ := note.Parse(tonic)
+	newRoot, err := root.TransposeToKey(fromKey, toKey)
+	if err != nil {
+		return "", err
+	}
+
+	return transposeChord(tonic, newRoot.String(), kind, bass)
+}
+
+// build a transposed chord name from the new root. The bass moves as the root moves.
+func transposeChord(tonic string, newRoot string, kind string, bass string) (string, error) {
+	chordName := newRoot + kind
+	if bass == "" {
+		return chordName, nil
+	}
+
+	b, _ := note.Parse(bass)
+	newBass, err := b.TransposeToKey(tonic, newRoot)
+	if err != nil {
+		return "", err
+	}
+
+	return chordName + "/" + newBass.String(), nil
+}
+
+// The major key on `Db`, `Eb`, `Ab` and `Bb` is written with flats.
+func isFlatPitchClass(pitchClass int) bool {
+	switch pitchClass {
+	case 1, 3, 8, 10:
+		return true
+	}
+
+	return false
+}
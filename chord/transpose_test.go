@@ -0,0 +1,74 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/bayashi/actually"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		name      string
+		semitones int
+		want      string
+	}{
+		{name: "Bb7(#9)", semitones: 3, want: "Db7(#9)"},
+		{name: "C", semitones: 3, want: "Eb"},
+		{name: "C", semitones: 6, want: "F#"},
+		{name: "F#m7", semitones: 2, want: "G#m7"},
+		{name: "Ebm7", semitones: 3, want: "Gbm7"},
+		{name: "Am7/G", semitones: -2, want: "Gm7/F"},
+		{name: "C/E", semitones: 1, want: "Db/F"},
+		{name: "G7", semitones: 17, want: "C7"},
+		{name: "D", semitones: -14, want: "C"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Transpose(test.name, test.semitones)
+			actually.Got(err).FailNow().Nil(t)
+			if actual != test.want {
+				t.Errorf(`Transpose("%v", %v), actual:"%v", want:"%v"`, test.name, test.semitones, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestTransposeToKey(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{name: "Bb7(#9)", from: "Bb", to: "D", want: "D7(#9)"},
+		{name: "EbM7", from: "Bb", to: "D", want: "GM7"},
+		{name: "Am7/G", from: "C", to: "Eb", want: "Cm7/Bb"},
+		{name: "B", from: "E", to: "Gb", want: "Db"},
+		{name: "CM7", from: "G", to: "Gb", want: "CbM7"},
+		{name: "Dm7", from: "Am", to: "Gm", want: "Cm7"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := TransposeToKey(test.name, test.from, test.to)
+			actually.Got(err).FailNow().Nil(t)
+			if actual != test.want {
+				t.Errorf(`TransposeToKey("%v", "%v", "%v"), actual:"%v", want:"%v"`, test.name, test.from, test.to, actual, test.want)
+			}
+			if _, err := GetChord(actual); err != nil {
+				t.Errorf(`TransposeToKey("%v", "%v", "%v") gives a wrong chord name "%v". %v`, test.name, test.from, test.to, actual, err)
+			}
+		})
+	}
+}
+
+func TestTransposeError(t *testing.T) {
+	if _, err := Transpose("CN7", 1); err == nil || err.Error() != ErrorNotFoundChordKind("N7").Error() {
+		t.Errorf(`Transpose("CN7") wants Error(%v). but it's wrong. "%v"`, ErrorNotFoundChordKind("N7"), err)
+	}
+	if _, err := TransposeToKey("C", "C", "X"); err == nil || err.Error() != note.ErrorNotFoundNote("X").Error() {
+		t.Errorf(`TransposeToKey("C", "C", "X") wants Error(%v). but it's wrong. "%v"`, note.ErrorNotFoundNote("X"), err)
+	}
+}
@@ -0,0 +1,126 @@
+package chord
+
+import (
+	"strconv"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// A chord parsed from full chord name `EbM7/G`
+type Chord struct {
+	// Root note name as written `Eb`
+	Root string
+	// Relative note number of the root `3`
+	RootPitchClass int
+	// Canonical kind of chord `M7`. It's a key of the chord table, `base` is for the major triad.
+	Kind string
+	// Note number list of the kind of chord `{0, 4, 7, 11}`
+	Intervals []int
+	// Bass note name as written `G`. It's empty if it's not a slash chord.
+	Bass string
+	// Original chord name `EbM7/G`
+	Symbol string
+}
+
+// Parse full chord name `EbM7/G` to `Chord`
+func Parse(chordName string) (Chord, error) {
+	tonic, kind, bass, err := splitChord(chordName)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	chordNumbers, err := GetChordAsNumberList(kind)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	if kind == "" {
+		kind = "base"
+	}
+
+	scalic, _ := note.NoteNumber(tonic)
+
+	return Chord{
+		Root:           tonic,
+		RootPitchClass: scalic,
+		Kind:           canonicalKind(kind),
+		Intervals:      append([]int{}, chordNumbers...),
+		Bass:           bass,
+		Symbol:         chordName,
+	}, nil
+}
+
+// Get a note number list relative to the root `{0, 4, 7, 11}`. On a slash chord `C/E`, the bass comes first `{4, 7, 0}`.
+func (c Chord) NumberList() []int {
+	if c.Bass == "" {
+		return append([]int{}, c.Intervals...)
+	}
+
+	bassNumber, _ := note.NoteNumber(c.Bass)
+
+	return withBass(c.Intervals, (bassNumber-c.RootPitchClass+12)%12)
+}
+
+// Get a note list `{"D#", "G", "A#", "D"}` in `BaseTones`. Use `SpelledNotes()` for `{"Eb", "G", "Bb", "D"}`.
+func (c Chord) Notes() []string {
+	var notes []string
+	for _, n := range c.NumberList() {
+		notes = append(notes, note.BaseTones[(n+c.RootPitchClass)%12])
+	}
+
+	return notes
+}
+
+// Get a correctly spelled note list `{"Eb", "G", "Bb", "D"}` from the root letter and the degree of each interval.
+func (c Chord) SpelledNotes() ([]string, error) {
+	var notes []string
+	for _, n := range c.Intervals {
+		spelled, err := note.SpellNote(c.Root, n, chordDegree(c.Kind, n))
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, spelled)
+	}
+
+	if c.Bass == "" {
+		return notes, nil
+	}
+
+	bassNumber, _ := note.NoteNumber(c.Bass)
+	i := bassIndex(c.Intervals, (bassNumber-c.RootPitchClass+12)%12)
+	if i < 0 {
+		return append([]string{c.Bass}, notes...), nil
+	}
+
+	return append(notes[i:], notes[:i]...), nil
+}
+
+// Get a note list `{"F4", "A4", "C#5", "E5"}` stacked upward from octave number `4`.
+func (c Chord) WithOctave(octave int) ([]string, error) {
+	var notesWithOctave []string
+	var lastPosition = -1
+	for _, n := range c.Notes() {
+		position, _ := note.NoteNumber(n)
+		if position < lastPosition {
+			octave++
+		}
+		nn := n + strconv.Itoa(octave)
+		if octave > 9 || (octave == 9 && position > 7) {
+			return nil, ErrorNoteOutOfRange(nn)
+		}
+		notesWithOctave = append(notesWithOctave, nn)
+		lastPosition = position
+	}
+
+	return notesWithOctave, nil
+}
+
+// Get canonical full chord name. `C7(b5)/E` -> `C7b5/E`
+func (c Chord) String() string {
+	s := c.Root + KindName(c.Kind)
+	if c.Bass != "" {
+		s += "/" + c.Bass
+	}
+
+	return s
+}
@@ -0,0 +1,122 @@
+package chord
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/actually"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want Chord
+	}{
+		{
+			name: "C",
+			want: Chord{Root: "C", RootPitchClass: 0, Kind: "base", Intervals: []int{0, 4, 7}, Symbol: "C"},
+		},
+		{
+			name: "EbM7/G",
+			want: Chord{Root: "Eb", RootPitchClass: 3, Kind: "M7", Intervals: []int{0, 4, 7, 11}, Bass: "G", Symbol: "EbM7/G"},
+		},
+		{
+			name: "F#7(b5)",
+			want: Chord{Root: "F#", RootPitchClass: 6, Kind: "7b5", Intervals: []int{0, 4, 6, 10}, Symbol: "F#7(b5)"},
+		},
+		{
+			name: "Adim6",
+			want: Chord{Root: "A", RootPitchClass: 9, Kind: "dim7", Intervals: []int{0, 3, 6, 9}, Symbol: "Adim6"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Parse(test.name)
+			actually.Got(err).FailNow().Nil(t)
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Parse("%v"), actual:"%+v", want:"%+v"`, test.name, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name string
+		want error
+	}{
+		{
+			name: "X",
+			want: ErrorNotFoundChord("X"),
+		},
+		{
+			name: "CN7",
+			want: ErrorNotFoundChordKind("N7"),
+		},
+		{
+			name: "C/X",
+			want: ErrorNotFoundBassNote("X"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.name)
+			actually.Got(err).FailNow().NotNil(t)
+			if err.Error() != test.want.Error() {
+				t.Errorf(`Parse("%v") wants Error(%v). but it's wrong. "%v"`, test.name, err, test.want)
+			}
+		})
+	}
+}
+
+func TestChordMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []int
+		notes   []string
+		spelled []string
+		octave4 []string
+		str     string
+	}{
+		{
+			name:    "EbM7",
+			numbers: []int{0, 4, 7, 11},
+			notes:   []string{"D#", "G", "A#", "D"},
+			spelled: []string{"Eb", "G", "Bb", "D"},
+			octave4: []string{"D#4", "G4", "A#4", "D5"},
+			str:     "EbM7",
+		},
+		{
+			name:    "C7(b9)/E",
+			numbers: []int{4, 7, 10, 13, 0},
+			notes:   []string{"E", "G", "A#", "C#", "C"},
+			spelled: []string{"E", "G", "Bb", "Db", "C"},
+			octave4: []string{"E4", "G4", "A#4", "C#5", "C6"},
+			str:     "C7b9/E",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := Parse(test.name)
+			actually.Got(err).FailNow().Nil(t)
+			if actual := c.NumberList(); !reflect.DeepEqual(actual, test.numbers) {
+				t.Errorf(`NumberList() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.numbers)
+			}
+			if actual := c.Notes(); !reflect.DeepEqual(actual, test.notes) {
+				t.Errorf(`Notes() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.notes)
+			}
+			if actual, _ := c.SpelledNotes(); !reflect.DeepEqual(actual, test.spelled) {
+				t.Errorf(`SpelledNotes() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.spelled)
+			}
+			if actual, _ := c.WithOctave(4); !reflect.DeepEqual(actual, test.octave4) {
+				t.Errorf(`WithOctave(4) of "%v", actual:"%v", want:"%v"`, test.name, actual, test.octave4)
+			}
+			if actual := c.String(); actual != test.str {
+				t.Errorf(`String() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.str)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+// `chord CM7 [--octave 4]` by `chord.GetChord()` or `chord.GetChordWithOctave()`
+func chordCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	octave := fs.Int("octave", note.ErrorInt, "octave number of the root -1 to 9")
+
+	return func(args []string) (result, error) {
+		chordName := args[0]
+		notes, err := chord.GetChord(chordName)
+		if *octave != note.ErrorInt {
+			if *octave < -1 || *octave > 9 {
+				return result{}, note.ErrorInvalidOctave
+			}
+			notes, err = chord.GetChordWithOctave(chordName, *octave)
+		}
+		if err != nil {
+			return result{}, err
+		}
+
+		res := result{
+			text: strings.Join(notes, " "),
+			json: struct {
+				Chord string   `json:"chord"`
+				Notes []string `json:"notes"`
+			}{Chord: chordName, Notes: notes},
+			header: []string{"chord", "note"},
+		}
+		for _, n := range notes {
+			res.rows = append(res.rows, []string{chordName, n})
+		}
+
+		return res, nil
+	}
+}
+
+// `note Eb4` by `note.NoteNumber()`
+func noteCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	return func(args []string) (result, error) {
+		noteName := args[0]
+		number, err := note.NoteNumber(noteName)
+		if err != nil {
+			return result{}, err
+		}
+
+		return result{
+			text: strconv.Itoa(number),
+			json: struct {
+				Note   string `json:"note"`
+				Number int    `json:"number"`
+			}{Note: noteName, Number: number},
+			header: []string{"note", "number"},
+			rows:   [][]string{{noteName, strconv.Itoa(number)}},
+		}, nil
+	}
+}
+
+// `scale dorian D4` by `scale.GetScaleFromRoot()`
+func scaleCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	return func(args []string) (result, error) {
+		scaleName, root := args[0], args[1]
+		numbers, err := scale.GetScaleFromRoot(scaleName, root)
+		if err != nil {
+			return result{}, err
+		}
+
+		res := result{
+			json: struct {
+				Scale   string `json:"scale"`
+				Root    string `json:"root"`
+				Numbers []int  `json:"numbers"`
+			}{Scale: scaleName, Root: root, Numbers: numbers},
+			header: []string{"scale", "root", "number"},
+		}
+		var texts []string
+		for _, n := range numbers {
+			texts = append(texts, strconv.Itoa(n))
+			res.rows = append(res.rows, []string{scaleName, root, strconv.Itoa(n)})
+		}
+		res.text = strings.Join(texts, " ")
+
+		return res, nil
+	}
+}
+
+// `identify C E G Bb` by `chord.IdentifyChord()`
+func identifyCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	return func(args []string) (result, error) {
+		candidates, err := chord.IdentifyChord(args)
+		if err != nil {
+			return result{}, err
+		}
+
+		type candidate struct {
+			Name    string   `json:"name"`
+			Root    string   `json:"root"`
+			Kind    string   `json:"kind"`
+			Bass    string   `json:"bass,omitempty"`
+			Score   float64  `json:"score"`
+			Reasons []string `json:"reasons"`
+		}
+		res := result{header: []string{"name", "root", "kind", "bass", "score"}}
+		var list []candidate
+		var lines []string
+		for _, c := range candidates {
+			score := strconv.FormatFloat(c.Score, 'f', 2, 64)
+			kind := chord.KindName(c.Kind)
+			list = append(list, candidate{Name: c.Name, Root: c.Root, Kind: kind, Bass: c.Bass, Score: c.Score, Reasons: c.Reasons})
+			lines = append(lines, fmt.Sprintf("%s\t%s", c.Name, score))
+			res.rows = append(res.rows, []string{c.Name, c.Root, kind, c.Bass, score})
+		}
+		res.text = strings.Join(lines, "\n")
+		res.json = list
+
+		return res, nil
+	}
+}
+
+// `transpose Bb7 +3` by `chord.Transpose()`, or `transpose Bb7 Bb D` by `chord.TransposeToKey()`
+func transposeCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	return func(args []string) (result, error) {
+		chordName := args[0]
+		var transposed string
+		var err error
+		if len(args) == 3 {
+			transposed, err = chord.TransposeToKey(chordName, args[1], args[2])
+		} else {
+			semitones, convErr := strconv.Atoi(args[1])
+			if convErr != nil {
+				return result{}, fmt.Errorf("%w. semitones should be a number `%s`", errUsage, args[1])
+			}
+			transposed, err = chord.Transpose(chordName, semitones)
+		}
+		if err != nil {
+			return result{}, err
+		}
+
+		return result{
+			text: transposed,
+			json: struct {
+				Chord      string `json:"chord"`
+				Transposed string `json:"transposed"`
+			}{Chord: chordName, Transposed: transposed},
+			header: []string{"chord", "transposed"},
+			rows:   [][]string{{chordName, transposed}},
+		}, nil
+	}
+}
+
+// `list chords|scales` by `chord.ListChords()` or `scale.ListScales()`, in order of names.
+// Kinds of chord are as in full chord names by `chord.KindName()`, so the major triad is an empty kind.
+// It's `(major)` on text not to be an empty line, and CSV of chords has note numbers too, since an empty line would be skipped.
+func listCommand(fs *flag.FlagSet) func(args []string) (result, error) {
+	return func(args []string) (result, error) {
+		var names []string
+		switch args[0] {
+		case "chords":
+			for _, kind := range chord.ListChords() {
+				names = append(names, chord.KindName(kind))
+			}
+		case "scales":
+			names = scale.ListScales()
+		default:
+			return result{}, fmt.Errorf("%w. list chords or scales `%s`", errUsage, args[0])
+		}
+		sort.Strings(names)
+
+		lines := append([]string{}, names...)
+		if len(lines) > 0 && lines[0] == "" {
+			lines[0] = "(major)"
+		}
+
+		res := result{text: strings.Join(lines, "\n"), json: names, header: []string{"name"}}
+		if args[0] == "chords" {
+			res.header = []string{"name", "numbers"}
+		}
+		for _, name := range names {
+			if args[0] != "chords" {
+				res.rows = append(res.rows, []string{name})
+				continue
+			}
+			numbers, err := chord.GetChordAsNumberList(name)
+			if err != nil {
+				return result{}, err
+			}
+			res.rows = append(res.rows, []string{name, strings.Trim(fmt.Sprint(numbers), "[]")})
+		}
+
+		return res, nil
+	}
+}
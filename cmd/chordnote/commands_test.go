@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"chord", "CM7"}, want: "C E G B\n"},
+		{args: []string{"chord", "CM7", "--octave", "4", "--json"}, want: `{"chord":"CM7","notes":["C4","E4","G4","B4"]}` + "\n"},
+		{args: []string{"chord", "G/B", "--csv"}, want: "chord,note\nG/B,B\nG/B,D\nG/B,G\n"},
+		{args: []string{"note", "Eb4"}, want: "63\n"},
+		{args: []string{"note", "Eb"}, want: "3\n"},
+		{args: []string{"note", "Eb4", "--json"}, want: `{"note":"Eb4","number":63}` + "\n"},
+		{args: []string{"note", "Eb4", "--csv"}, want: "note,number\nEb4,63\n"},
+		{args: []string{"scale", "dorian", "D4"}, want: "62 64 65 67 69 71 72\n"},
+		{args: []string{"scale", "dorian", "D4", "--json"}, want: `{"scale":"dorian","root":"D4","numbers":[62,64,65,67,69,71,72]}` + "\n"},
+		{args: []string{"scale", "pentatonic-minor", "A3", "--csv"}, want: "scale,root,number\npentatonic-minor,A3,57\npentatonic-minor,A3,60\npentatonic-minor,A3,62\npentatonic-minor,A3,64\npentatonic-minor,A3,67\n"},
+		{args: []string{"identify", "C", "E", "G", "Bb"}, want: "C7\t1.00\nEdim/C\t0.80\nGm6/C\t0.70\n"},
+		{args: []string{"identify", "E", "G", "C", "--csv"}, want: "name,root,kind,bass,score\nC/E,C,,E,0.95\nGsus/E,G,sus,E,0.70\n"},
+		{args: []string{"transpose", "Bb7", "+3"}, want: "Db7\n"},
+		{args: []string{"transpose", "Bb7", "Bb", "D"}, want: "D7\n"},
+		{args: []string{"transpose", "C/E", "1", "--json"}, want: `{"chord":"C/E","transposed":"Db/F"}` + "\n"},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		if code := run(test.args, &stdout, &stderr); code != exitOK {
+			t.Errorf(`run(%q), actual code:%d. %s`, test.args, code, stderr.String())
+			continue
+		}
+		if stdout.String() != test.want {
+			t.Errorf(`run(%q), actual:%q, want:%q`, test.args, stdout.String(), test.want)
+		}
+	}
+}
+
+func TestIdentifyJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"identify", "E", "G", "C", "--json"}, &stdout, &stderr); code != exitOK {
+		t.Fatalf(`identify, actual code:%d. %s`, code, stderr.String())
+	}
+	if want := `[{"name":"C/E","root":"C","kind":"","bass":"E","score":0.95,"reasons":[`; !strings.HasPrefix(stdout.String(), want) {
+		t.Errorf(`identify --json, actual:%q, want prefix:%q`, stdout.String(), want)
+	}
+}
+
+func TestList(t *testing.T) {
+	for _, kind := range []string{"chords", "scales"} {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{"list", kind}, &stdout, &stderr); code != exitOK {
+			t.Fatalf(`list %s, actual code:%d. %s`, kind, code, stderr.String())
+		}
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		for i := 1; i < len(lines); i++ {
+			if lines[i-1] >= lines[i] {
+				t.Errorf(`list %s should be sorted, actual:%q`, kind, lines)
+				break
+			}
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	run([]string{"list", "scales", "--csv"}, &stdout, &stderr)
+	if !strings.HasPrefix(stdout.String(), "name\naeolian\n") {
+		t.Errorf(`list scales --csv, actual:%q`, stdout.String())
+	}
+
+	// the major triad is an empty kind, not `base`, and it's labeled on text
+	stdout.Reset()
+	run([]string{"list", "chords"}, &stdout, &stderr)
+	if first := strings.SplitN(stdout.String(), "\n", 2)[0]; first != "(major)" || strings.Contains(stdout.String(), "base") {
+		t.Errorf(`list chords, actual first line:%q`, first)
+	}
+	stdout.Reset()
+	run([]string{"list", "chords", "--json"}, &stdout, &stderr)
+	if !strings.HasPrefix(stdout.String(), `["","-5",`) {
+		t.Errorf(`list chords --json, actual:%q`, stdout.String())
+	}
+	stdout.Reset()
+	run([]string{"list", "chords", "--csv"}, &stdout, &stderr)
+	if !strings.HasPrefix(stdout.String(), "name,numbers\n,0 4 7\n-5,0 4 6\n") {
+		t.Errorf(`list chords --csv, actual:%q`, stdout.String())
+	}
+}
@@ -0,0 +1,217 @@
+// Command chordnote queries chords, notes and scales.
+//
+//	chordnote chord CM7 [--octave 4]
+//	chordnote note Eb4
+//	chordnote scale dorian D4
+//	chordnote identify C E G Bb
+//	chordnote transpose Bb7 +3
+//	chordnote list chords|scales
+//
+// Every subcommand takes `--json` or `--csv` for the output format.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+// Exit codes
+const (
+	exitOK         = 0
+	exitError      = 1
+	exitUsage      = 2
+	exitNotFound   = 3
+	exitOutOfRange = 4
+)
+
+const usage = `Usage: chordnote <command> [arguments] [--json|--csv]
+
+Commands:
+  chord <chord> [--octave <octave>]       notes of a chord "CM7"
+  note <note>                             note number of a note "Eb4"
+  scale <scale> <root>                    note numbers of a scale "dorian D4"
+  identify <note> <note>...               chords from notes "C E G Bb", the first note is the bass
+  transpose <chord> <semitones>           transpose a chord "Bb7 +3"
+  transpose <chord> <from-key> <to-key>   transpose a chord from a key to another "Bb7 Bb D"
+  list chords|scales                      names of chords or scales
+
+Exit codes:
+  0 OK, 1 error, 2 usage error, 3 not found, 4 out of range
+`
+
+// Output of a command in each format
+type result struct {
+	// Plain text without the last newline
+	text string
+	// A value to be encoded as JSON
+	json interface{}
+	// Records of CSV with a header
+	header []string
+	rows   [][]string
+}
+
+// A command takes arguments without flags
+type command struct {
+	// Number of arguments. `max` is -1 for any.
+	min int
+	max int
+	// Define flags of the command, and get a function to run it
+	define func(fs *flag.FlagSet) func(args []string) (result, error)
+}
+
+var commands = map[string]command{
+	"chord":     {min: 1, max: 1, define: chordCommand},
+	"note":      {min: 1, max: 1, define: noteCommand},
+	"scale":     {min: 2, max: 2, define: scaleCommand},
+	"identify":  {min: 2, max: -1, define: identifyCommand},
+	"transpose": {min: 2, max: 3, define: transposeCommand},
+	"list":      {min: 1, max: 1, define: listCommand},
+}
+
+// errUsage is for wrong arguments
+var errUsage = errors.New("Invalid arguments")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run a command with arguments, and get the exit code
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "chordnote: unknown command %q\n\n%s", args[0], usage)
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	asJSON := fs.Bool("json", false, "output as JSON")
+	asCSV := fs.Bool("csv", false, "output as CSV")
+	exec := cmd.define(fs)
+
+	positional, err := parseArgs(fs, args[1:])
+	if err == nil && *asJSON && *asCSV {
+		err = errors.New("--json and --csv are exclusive")
+	}
+	if err == nil && (len(positional) < cmd.min || cmd.max >= 0 && len(positional) > cmd.max) {
+		err = fmt.Errorf("%s needs %s", args[0], countOf(cmd))
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "chordnote: %v\n\n%s", err, usage)
+		return exitUsage
+	}
+
+	res, err := exec(positional)
+	if err != nil {
+		fmt.Fprintf(stderr, "chordnote: %v\n", err)
+		return exitCode(err)
+	}
+
+	switch {
+	case *asJSON:
+		err = json.NewEncoder(stdout).Encode(res.json)
+	case *asCSV:
+		w := csv.NewWriter(stdout)
+		err = w.WriteAll(append([][]string{res.header}, res.rows...))
+	default:
+		_, err = fmt.Fprintln(stdout, res.text)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "chordnote: %v\n", err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// semitones as an argument `-3`, which isn't a flag
+var semitonesRegexp = regexp.MustCompile(`^[+-][0-9]+$`)
+
+// parse flags which can be anywhere in arguments, and get other arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		arg := args[0]
+		if arg == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" || semitonesRegexp.MatchString(arg) {
+			positional = append(positional, arg)
+			args = args[1:]
+			continue
+		}
+
+		// a flag takes the next argument as its value, unless it's a bool flag or has `=`
+		n := 2
+		name := strings.TrimLeft(arg, "-")
+		if f := fs.Lookup(name); f == nil || strings.Contains(name, "=") || isBoolFlag(f) {
+			n = 1
+		}
+		if n > len(args) {
+			n = len(args)
+		}
+		if err := fs.Parse(args[:n]); err != nil {
+			return nil, err
+		}
+		args = args[n:]
+	}
+
+	return positional, nil
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return ok && b.IsBoolFlag()
+}
+
+func countOf(cmd command) string {
+	switch {
+	case cmd.min == cmd.max:
+		return fmt.Sprintf("%d arguments", cmd.min)
+	case cmd.max < 0:
+		return fmt.Sprintf("%d arguments or more", cmd.min)
+	}
+
+	return fmt.Sprintf("%d to %d arguments", cmd.min, cmd.max)
+}
+
+// get the exit code of an error by the sentinel error which it wraps
+func exitCode(err error) int {
+	for _, e := range []error{note.ErrNotFound, note.ErrNotFoundOctave, chord.ErrNotFound, chord.ErrNotFoundKind,
+		chord.ErrNotFoundBassNote, chord.ErrCouldNotIdentify, scale.ErrNotFound} {
+		if errors.Is(err, e) {
+			return exitNotFound
+		}
+	}
+	for _, e := range []error{note.ErrOutOfRange, note.ErrInvalidOctave, chord.ErrNoteOutOfRange} {
+		if errors.Is(err, e) {
+			return exitOutOfRange
+		}
+	}
+	if errors.Is(err, errUsage) {
+		return exitUsage
+	}
+
+	return exitError
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{args: []string{"help"}, code: exitOK, stdout: "Usage: chordnote"},
+		{args: []string{}, code: exitUsage, stderr: "Usage: chordnote"},
+		{args: []string{"chords"}, code: exitUsage, stderr: `unknown command "chords"`},
+		{args: []string{"chord"}, code: exitUsage, stderr: "chord needs 1 arguments"},
+		{args: []string{"chord", "C", "D"}, code: exitUsage, stderr: "chord needs 1 arguments"},
+		{args: []string{"identify", "C"}, code: exitUsage, stderr: "identify needs 2 arguments or more"},
+		{args: []string{"chord", "C", "--json", "--csv"}, code: exitUsage, stderr: "exclusive"},
+		{args: []string{"chord", "C", "--yaml"}, code: exitUsage, stderr: "flag provided but not defined"},
+		{args: []string{"chord", "C", "--octave", "four"}, code: exitUsage, stderr: "invalid value"},
+		{args: []string{"transpose", "Bb7", "up"}, code: exitUsage, stderr: "semitones should be a number"},
+		{args: []string{"list", "kinds"}, code: exitUsage, stderr: "list chords or scales"},
+		{args: []string{"chord", "CN7"}, code: exitNotFound, stderr: "Not found chord Kind"},
+		{args: []string{"note", "X4"}, code: exitNotFound, stderr: "Not found note"},
+		{args: []string{"scale", "unknown", "C4"}, code: exitNotFound, stderr: "Not found scale"},
+		{args: []string{"identify", "C", "C#", "D"}, code: exitNotFound, stderr: "Could not identify chord"},
+		{args: []string{"chord", "CM7", "--octave", "9"}, code: exitOutOfRange, stderr: "out of range"},
+		{args: []string{"chord", "CM7", "--octave", "12"}, code: exitOutOfRange, stderr: "should be -1 to 9"},
+		{args: []string{"chord", "CM7", "--octave", "-5"}, code: exitOutOfRange, stderr: "should be -1 to 9"},
+		{args: []string{"note", "C10"}, code: exitNotFound, stderr: "Not found note"},
+		{args: []string{"transpose", "Bb7", "X", "D"}, code: exitNotFound, stderr: "Not found note"},
+		{args: []string{"chord", "CM7"}, code: exitOK, stdout: "C E G B\n"},
+		{args: []string{"chord", "--octave=3", "Am"}, code: exitOK, stdout: "A3 C4 E4\n"},
+		{args: []string{"transpose", "Bb7", "-3"}, code: exitOK, stdout: "G7\n"},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.args, &stdout, &stderr)
+		if code != test.code {
+			t.Errorf(`run(%q), actual code:%d, want:%d. %s`, test.args, code, test.code, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), test.stdout) || test.stdout == "" && stdout.Len() > 0 {
+			t.Errorf(`run(%q), actual stdout:%q, want:%q`, test.args, stdout.String(), test.stdout)
+		}
+		if !strings.Contains(strings.ToLower(stderr.String()), strings.ToLower(test.stderr)) || test.stderr == "" && stderr.Len() > 0 {
+			t.Errorf(`run(%q), actual stderr:%q, want:%q`, test.args, stderr.String(), test.stderr)
+		}
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		args   []string
+		want   []string
+		octave int
+		asJSON bool
+	}{
+		{args: []string{"CM7"}, want: []string{"CM7"}},
+		{args: []string{"CM7", "--octave", "4", "--json"}, want: []string{"CM7"}, octave: 4, asJSON: true},
+		{args: []string{"--json", "Bb7", "+3"}, want: []string{"Bb7", "+3"}, asJSON: true},
+		{args: []string{"Bb7", "-3", "-octave=2"}, want: []string{"Bb7", "-3"}, octave: 2},
+		{args: []string{"--", "--json"}, want: []string{"--json"}},
+	}
+
+	for _, test := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		octave := fs.Int("octave", 0, "")
+		asJSON := fs.Bool("json", false, "")
+		actual, err := parseArgs(fs, test.args)
+		if err != nil || !reflect.DeepEqual(actual, test.want) || *octave != test.octave || *asJSON != test.asJSON {
+			t.Errorf(`parseArgs(%q), actual:%q octave:%d json:%v, want:%q. %v`, test.args, actual, *octave, *asJSON, test.want, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Command chordnoted serves a JSON API of chords, notes and scales on the local machine.
+//
+//	chordnoted [-addr 127.0.0.1:8080]
+//
+// See `openapi.json` for the API, which is also served on `/openapi.json`.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8080", "address to listen on")
+	flag.Parse()
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	log.Printf("chordnoted: listening on http://%s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+//go:embed openapi.json
+var openAPI []byte
+
+// Codes of errors in a response body
+const (
+	codeNotFound         = "not_found"
+	codeOutOfRange       = "out_of_range"
+	codeInvalidArgument  = "invalid_argument"
+	codeMethodNotAllowed = "method_not_allowed"
+	codeInternal         = "internal"
+)
+
+// A response body of an error
+type errorBody struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// The part of the name which could not be parsed, on a parse error
+	Input string `json:"input,omitempty"`
+	Pos   *int   `json:"pos,omitempty"`
+}
+
+// errInvalidArgument is for a wrong query or path
+var errInvalidArgument = errors.New("Invalid argument")
+
+// Get the handler of the API
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chords/", get(chordHandler))
+	mux.HandleFunc("/notes/", get(noteHandler))
+	mux.HandleFunc("/scales/", get(scaleHandler))
+	mux.HandleFunc("/identify", get(identifyHandler))
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, errorDetail{Code: codeMethodNotAllowed, Message: "Method not allowed"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPI)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, errorDetail{Code: codeNotFound, Message: "Not found path. `" + r.URL.Path + "`"})
+	})
+
+	return mux
+}
+
+// wrap a handler which gets a value to respond, only for GET
+func get(handler func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, errorDetail{Code: codeMethodNotAllowed, Message: "Method not allowed"})
+			return
+		}
+
+		v, err := handler(r)
+		if err != nil {
+			status, detail := errorOf(err)
+			writeError(w, status, detail)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, v)
+	}
+}
+
+// `GET /chords/CM7?octave=4` by `chord.GetChord()` or `chord.GetChordWithOctave()`. A slash chord is `/chords/G/B`.
+func chordHandler(r *http.Request) (interface{}, error) {
+	chordName, err := pathValue(r, "/chords/")
+	if err != nil {
+		return nil, err
+	}
+
+	var notes []string
+	if q := r.URL.Query().Get("octave"); q != "" {
+		octave, convErr := strconv.Atoi(q)
+		if convErr != nil {
+			return nil, invalidArgument("octave should be a number", q)
+		}
+		if octave < -1 || octave > 9 {
+			return nil, note.ErrorInvalidOctave
+		}
+		notes, err = chord.GetChordWithOctave(chordName, octave)
+	} else {
+		notes, err = chord.GetChord(chordName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Chord string   `json:"chord"`
+		Notes []string `json:"notes"`
+	}{Chord: chordName, Notes: notes}, nil
+}
+
+// `GET /notes/Eb4` by `note.NoteNumber()`
+func noteHandler(r *http.Request) (interface{}, error) {
+	noteName, err := pathValue(r, "/notes/")
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := note.NoteNumber(noteName)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Note   string `json:"note"`
+		Number int    `json:"number"`
+	}{Note: noteName, Number: number}, nil
+}
+
+// `GET /scales/dorian?root=D4` by `scale.GetScaleFromRoot()`, or `scale.GetScale()` without the root
+func scaleHandler(r *http.Request) (interface{}, error) {
+	scaleName, err := pathValue(r, "/scales/")
+	if err != nil {
+		return nil, err
+	}
+
+	root := r.URL.Query().Get("root")
+	var numbers []int
+	if root != "" {
+		numbers, err = scale.GetScaleFromRoot(scaleName, root)
+	} else {
+		numbers, err = scale.GetScale(scaleName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Scale   string `json:"scale"`
+		Root    string `json:"root,omitempty"`
+		Numbers []int  `json:"numbers"`
+	}{Scale: scaleName, Root: root, Numbers: numbers}, nil
+}
+
+// `GET /identify?notes=C,E,G` by `chord.IdentifyChord()`. The kind is by `chord.KindName()`, so the major triad is an empty kind.
+func identifyHandler(r *http.Request) (interface{}, error) {
+	q := r.URL.Query().Get("notes")
+	if q == "" {
+		return nil, invalidArgument("notes are required", q)
+	}
+
+	candidates, err := chord.IdentifyChord(strings.Split(q, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		Name    string   `json:"name"`
+		Root    string   `json:"root"`
+		Kind    string   `json:"kind"`
+		Bass    string   `json:"bass,omitempty"`
+		Score   float64  `json:"score"`
+		Reasons []string `json:"reasons"`
+	}
+	list := []candidate{}
+	for _, c := range candidates {
+		list = append(list, candidate{Name: c.Name, Root: c.Root, Kind: chord.KindName(c.Kind), Bass: c.Bass, Score: c.Score, Reasons: c.Reasons})
+	}
+
+	return struct {
+		Notes      []string    `json:"notes"`
+		Candidates []candidate `json:"candidates"`
+	}{Notes: strings.Split(q, ","), Candidates: list}, nil
+}
+
+// get the rest of the path after the prefix
+func pathValue(r *http.Request, prefix string) (string, error) {
+	v := strings.TrimPrefix(r.URL.Path, prefix)
+	if v == "" {
+		return "", invalidArgument("name is required in the path", r.URL.Path)
+	}
+
+	return v, nil
+}
+
+func invalidArgument(reason string, input string) error {
+	return note.NewParseError(input, 0, fmt.Errorf("%w. %s", errInvalidArgument, reason))
+}
+
+// get the status and the detail of an error by the sentinel error which it wraps
+func errorOf(err error) (int, errorDetail) {
+	detail := errorDetail{Code: codeInternal, Message: err.Error()}
+	status := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, errInvalidArgument) || errors.Is(err, chord.ErrNotEnoughNotes):
+		status, detail.Code = http.StatusBadRequest, codeInvalidArgument
+	case errors.Is(err, note.ErrNotFound) || errors.Is(err, note.ErrNotFoundOctave) || errors.Is(err, chord.ErrNotFound) ||
+		errors.Is(err, chord.ErrNotFoundKind) || errors.Is(err, chord.ErrNotFoundBassNote) ||
+		errors.Is(err, chord.ErrCouldNotIdentify) || errors.Is(err, scale.ErrNotFound):
+		status, detail.Code = http.StatusNotFound, codeNotFound
+	case errors.Is(err, note.ErrOutOfRange) || errors.Is(err, note.ErrInvalidOctave) || errors.Is(err, chord.ErrNoteOutOfRange):
+		status, detail.Code = http.StatusBadRequest, codeOutOfRange
+	}
+
+	var parseError *note.ParseError
+	if errors.As(err, &parseError) {
+		pos := parseError.Pos
+		detail.Input, detail.Pos = parseError.Input, &pos
+	}
+
+	return status, detail
+}
+
+func writeError(w http.ResponseWriter, status int, detail errorDetail) {
+	writeJSON(w, status, errorBody{Error: detail})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
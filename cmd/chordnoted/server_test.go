@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func request(t *testing.T, server *httptest.Server, path string) (int, string) {
+	t.Helper()
+
+	res, err := server.Client().Get(server.URL + path)
+	if err != nil {
+		t.Fatalf(`GET %s, unexpected error:%v`, path, err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf(`GET %s, actual Content-Type:%q`, path, ct)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf(`GET %s, unexpected error:%v`, path, err)
+	}
+
+	return res.StatusCode, string(body)
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/chords/CM7", want: `{"chord":"CM7","notes":["C","E","G","B"]}`},
+		{path: "/chords/CM7?octave=4", want: `{"chord":"CM7","notes":["C4","E4","G4","B4"]}`},
+		{path: "/chords/G/B", want: `{"chord":"G/B","notes":["B","D","G"]}`},
+		{path: "/chords/C%23m7", want: `{"chord":"C#m7","notes":["C#","E","G#","B"]}`},
+		{path: "/notes/Eb4", want: `{"note":"Eb4","number":63}`},
+		{path: "/scales/dorian?root=D4", want: `{"scale":"dorian","root":"D4","numbers":[62,64,65,67,69,71,72]}`},
+		{path: "/scales/dorian", want: `{"scale":"dorian","numbers":[0,2,3,5,7,9,10]}`},
+		{path: "/identify?notes=C,E,G", want: `{"notes":["C","E","G"],"candidates":[{"name":"C","root":"C","kind":"","score":1,"reasons":["root position"]},` +
+			`{"name":"Em/C","root":"E","kind":"m","bass":"C","score":0.7,"reasons":["non-chord bass","missing 5th"]}]}`},
+	}
+
+	for _, test := range tests {
+		status, body := request(t, server, test.path)
+		if status != http.StatusOK {
+			t.Errorf(`GET %s, actual status:%d. %s`, test.path, status, body)
+			continue
+		}
+		if body != test.want+"\n" {
+			t.Errorf(`GET %s, actual:%q, want:%q`, test.path, body, test.want)
+		}
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	tests := []struct {
+		path   string
+		status int
+		want   string
+	}{
+		{path: "/chords/CN7", status: http.StatusNotFound, want: "{\"error\":{\"code\":\"not_found\",\"message\":\"Not found chord Kind. `N7`\",\"input\":\"N7\",\"pos\":1}}"},
+		{path: "/chords/B?octave=9", status: http.StatusBadRequest, want: "{\"error\":{\"code\":\"out_of_range\",\"message\":\"Note out of range. `B9`\"}}"},
+		{path: "/chords/C?octave=10", status: http.StatusBadRequest, want: "{\"error\":{\"code\":\"out_of_range\",\"message\":\"`octave` should be -1 to 9.\"}}"},
+		{path: "/chords/C?octave=-5", status: http.StatusBadRequest, want: "{\"error\":{\"code\":\"out_of_range\",\"message\":\"`octave` should be -1 to 9.\"}}"},
+		{path: "/chords/C?octave=x", status: http.StatusBadRequest, want: "{\"error\":{\"code\":\"invalid_argument\",\"message\":\"Invalid argument. octave should be a number. `x`\",\"input\":\"x\",\"pos\":0}}"},
+		{path: "/notes/X", status: http.StatusNotFound, want: "{\"error\":{\"code\":\"not_found\",\"message\":\"Not found note. `X`\",\"input\":\"X\",\"pos\":0}}"},
+		{path: "/scales/foo?root=C4", status: http.StatusNotFound, want: "{\"error\":{\"code\":\"not_found\",\"message\":\"Not found scale. `foo`\",\"input\":\"foo\",\"pos\":0}}"},
+		{path: "/identify?notes=C", status: http.StatusBadRequest, want: "{\"error\":{\"code\":\"invalid_argument\",\"message\":\"Need 2 notes at least to identify a chord.\"}}"},
+		{path: "/foo", status: http.StatusNotFound, want: "{\"error\":{\"code\":\"not_found\",\"message\":\"Not found path. `/foo`\"}}"},
+	}
+
+	for _, test := range tests {
+		status, body := request(t, server, test.path)
+		if status != test.status {
+			t.Errorf(`GET %s, actual status:%d, want:%d`, test.path, status, test.status)
+		}
+		if body != test.want+"\n" {
+			t.Errorf(`GET %s, actual:%q, want:%q`, test.path, body, test.want)
+		}
+	}
+
+	for _, path := range []string{"/chords/", "/identify"} {
+		if status, body := request(t, server, path); status != http.StatusBadRequest || !strings.Contains(body, `"code":"invalid_argument"`) {
+			t.Errorf(`GET %s, actual status:%d, body:%s`, path, status, body)
+		}
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	res, err := server.Client().Post(server.URL+"/notes/C4", "application/json", nil)
+	if err != nil {
+		t.Fatalf(`unexpected error:%v`, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusMethodNotAllowed || res.Header.Get("Allow") != http.MethodGet {
+		t.Errorf(`POST /notes/C4, actual status:%d, Allow:%q`, res.StatusCode, res.Header.Get("Allow"))
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	status, body := request(t, server, "/openapi.json")
+	if status != http.StatusOK {
+		t.Fatalf(`GET /openapi.json, actual status:%d`, status)
+	}
+
+	var doc struct {
+		OpenAPI string                 `json:"openapi"`
+		Paths   map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		t.Fatalf(`openapi.json should be JSON. %v`, err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		t.Errorf(`openapi, actual:%q`, doc.OpenAPI)
+	}
+	for _, path := range []string{"/chords/{chord}", "/notes/{note}", "/scales/{scale}", "/identify", "/openapi.json"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf(`openapi.json should have the path %q`, path)
+		}
+	}
+}
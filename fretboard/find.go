@@ -0,0 +1,363 @@
+package fretboard
+
+import (
+	"sort"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// A chord tone which is required in a fingering
+type Tone string
+
+const (
+	Root    Tone = "root"
+	Third   Tone = "3rd"
+	Fifth   Tone = "5th"
+	Seventh Tone = "7th"
+)
+
+// Options of finding fingerings. Zero values are for defaults.
+type Options struct {
+	// `Standard` by default
+	Tuning Tuning
+	// The most frets between the lowest and the highest pressed frets. `3` by default, i.e. within 4 frets. `Zero` for pressed frets on one fret.
+	MaxSpan int
+	// The highest fret. `12` by default. `Zero` for open strings only.
+	MaxFret int
+	// The least number of sounding strings. 2 less than strings by default, and the number of required tones at least.
+	MinStrings int
+	// Don't use open strings i.e. for movable shapes
+	NoOpen bool
+	// Allow muted strings between sounding strings
+	MutedInside bool
+	// Required chord tones. Other chord tones can be omitted. All chord tones but the 5th of a chord of 4 tones or more by default.
+	Required []Tone
+	// Allow any chord tone on the lowest note. The root, or the bass of a slash chord is on the lowest note by default,
+	// but any chord tone is allowed on a re-entrant tuning, since its lowest note is often not on the lowest string i.e. `2010` for `F` on `Ukulele`.
+	AnyBass bool
+}
+
+// Default options
+const (
+	DefaultMaxSpan = 3
+	DefaultMaxFret = 12
+	// 0 for `MaxSpan` and `MaxFret`, since the zero value is for the default
+	Zero = -1
+)
+
+// Find fingerings of full chord name `Am7` ranked by playability, the easiest first. See `FindChord()`.
+func Find(chordName string, opts Options) ([]Fingering, error) {
+	c, err := chord.Parse(chordName)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindChord(c, opts)
+}
+
+// Find fingerings of `chord.Chord` ranked by playability, the easiest first.
+// Every sounding string is a chord tone, and a fingering needs 4 fingers or less.
+// A barre by the index finger is used on the lowest pressed fret when more than 4 strings are pressed.
+// Difficulty adds up fingers, the span, a barre, muted strings and the position.
+func FindChord(c chord.Chord, opts Options) ([]Fingering, error) {
+	opts, err := withDefaults(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newFinder(c, opts)
+	f.search(0, make([]int, len(opts.Tuning.Strings)))
+	if len(f.fingerings) == 0 {
+		return nil, ErrorNotFound(c.Symbol, opts.Tuning)
+	}
+
+	sort.SliceStable(f.fingerings, func(i, j int) bool {
+		a, b := f.fingerings[i], f.fingerings[j]
+		if a.Difficulty != b.Difficulty {
+			return a.Difficulty < b.Difficulty
+		}
+		if lowestPressed(a.Frets) != lowestPressed(b.Frets) {
+			return lowestPressed(a.Frets) < lowestPressed(b.Frets)
+		}
+		return a.String() < b.String()
+	})
+
+	return f.fingerings, nil
+}
+
+type finder struct {
+	opts Options
+	// pitch classes which can sound
+	allowed map[int]bool
+	// pitch classes which should sound
+	required map[int]bool
+	// pitch class of the lowest note
+	bass int
+	// the bass of a slash chord which isn't a chord tone, or -1
+	extraBass  int
+	fingerings []Fingering
+}
+
+func newFinder(c chord.Chord, opts Options) *finder {
+	f := &finder{opts: opts, allowed: map[int]bool{}, required: map[int]bool{}, bass: c.RootPitchClass, extraBass: -1}
+	f.opts.AnyBass = opts.AnyBass || opts.Tuning.IsReentrant()
+
+	var tones []int
+	for _, n := range c.Intervals {
+		if !f.allowed[(c.RootPitchClass+n)%12] {
+			tones = append(tones, n%12)
+		}
+		f.allowed[(c.RootPitchClass+n)%12] = true
+	}
+
+	required := opts.Required
+	if required == nil {
+		for _, n := range tones {
+			if n != 7 || len(tones) < 4 {
+				f.required[(c.RootPitchClass+n)%12] = true
+			}
+		}
+	}
+	for _, tone := range required {
+		if n := toneOf(tones, tone); n >= 0 {
+			f.required[(c.RootPitchClass+n)%12] = true
+		}
+	}
+
+	if c.Bass != "" {
+		b, _ := note.NoteNumber(c.Bass)
+		f.bass = b % 12
+		f.required[f.bass] = true
+		if !f.allowed[f.bass] {
+			f.extraBass = f.bass
+			f.allowed[f.bass] = true
+		}
+	}
+
+	if f.opts.MinStrings == 0 {
+		f.opts.MinStrings = len(opts.Tuning.Strings) - 2
+		if f.opts.MinStrings < len(f.required) {
+			f.opts.MinStrings = len(f.required)
+		}
+	}
+
+	return f
+}
+
+// try every fret of a string from the string `i`, keeping pressed frets in the span
+func (f *finder) search(i int, frets []int) {
+	if i == len(frets) {
+		if fingering, ok := f.evaluate(frets); ok {
+			f.fingerings = append(f.fingerings, fingering)
+		}
+		return
+	}
+
+	frets[i] = -1
+	f.search(i+1, frets)
+
+	low, high := lowestPressed(frets[:i]), highestPressed(frets[:i])
+	for fret := 0; fret <= f.opts.MaxFret; fret++ {
+		if fret == 0 && f.opts.NoOpen {
+			continue
+		}
+		if fret > 0 && low > 0 && (fret-low > f.opts.MaxSpan || high-fret > f.opts.MaxSpan) {
+			continue
+		}
+		if !f.allowed[(f.opts.Tuning.Strings[i]+fret)%12] {
+			continue
+		}
+		frets[i] = fret
+		f.search(i+1, frets)
+	}
+}
+
+func (f *finder) evaluate(frets []int) (Fingering, bool) {
+	var notes []int
+	first, last, muted := -1, -1, 0
+	for i, fret := range frets {
+		if fret < 0 {
+			muted++
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+		notes = append(notes, f.opts.Tuning.Strings[i]+fret)
+	}
+	if len(notes) < f.opts.MinStrings || len(notes) == 0 {
+		return Fingering{}, false
+	}
+	if !f.opts.MutedInside && last-first+1 != len(notes) {
+		return Fingering{}, false
+	}
+
+	lowest := 0
+	sounding := map[int]bool{}
+	for i, n := range notes {
+		sounding[n%12] = true
+		if n < notes[lowest] {
+			lowest = i
+		}
+	}
+	for pc := range f.required {
+		if !sounding[pc] {
+			return Fingering{}, false
+		}
+	}
+	if !f.opts.AnyBass && notes[lowest]%12 != f.bass {
+		return Fingering{}, false
+	}
+	for i, n := range notes {
+		if !f.opts.AnyBass && n%12 == f.extraBass && i != lowest {
+			return Fingering{}, false
+		}
+	}
+
+	fingers, barre := fingersOf(frets)
+	if fingers > 4 {
+		return Fingering{}, false
+	}
+
+	span := 0
+	if low := lowestPressed(frets); low > 0 {
+		span = highestPressed(frets) - low
+	}
+	difficulty := 2*fingers + 2*span + 4*muted + lowestPressed(frets)/2
+	if barre > 0 {
+		difficulty += 2
+	}
+
+	return Fingering{
+		Frets:      append([]int{}, frets...),
+		Notes:      notes,
+		Barre:      barre,
+		Fingers:    fingers,
+		Difficulty: difficulty,
+	}, true
+}
+
+// count fingers to press frets, and get the fret of a barre if it's needed
+func fingersOf(frets []int) (int, int) {
+	pressed := 0
+	for _, fret := range frets {
+		if fret > 0 {
+			pressed++
+		}
+	}
+	if pressed <= 4 {
+		return pressed, 0
+	}
+
+	// a barre covers strings from the first to the last string on the lowest pressed fret
+	low := lowestPressed(frets)
+	first, last := -1, -1
+	for i, fret := range frets {
+		if fret == low {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first == last {
+		return pressed, 0
+	}
+	fingers := 1
+	for i, fret := range frets {
+		if i >= first && i <= last && fret < low {
+			return pressed, 0 // a muted or open string under the barre
+		}
+		if fret > low {
+			fingers++
+		}
+	}
+
+	return fingers, low
+}
+
+func toneOf(tones []int, tone Tone) int {
+	var candidates []int
+	switch tone {
+	case Root:
+		candidates = []int{0}
+	case Third:
+		candidates = []int{4, 3}
+	case Fifth:
+		candidates = []int{7, 6, 8}
+	case Seventh:
+		candidates = []int{10, 11}
+	}
+	for _, c := range candidates {
+		for _, n := range tones {
+			if n == c {
+				return c
+			}
+		}
+	}
+
+	return -1
+}
+
+// the lowest pressed fret, or 0 if no fret is pressed
+func lowestPressed(frets []int) int {
+	low := 0
+	for _, fret := range frets {
+		if fret > 0 && (low == 0 || fret < low) {
+			low = fret
+		}
+	}
+
+	return low
+}
+
+// the highest pressed fret, or 0 if no fret is pressed
+func highestPressed(frets []int) int {
+	high := 0
+	for _, fret := range frets {
+		if fret > high {
+			high = fret
+		}
+	}
+
+	return high
+}
+
+func withDefaults(opts Options) (Options, error) {
+	if opts.Tuning.Strings == nil {
+		opts.Tuning = Standard
+	}
+	if err := opts.Tuning.validate(); err != nil {
+		return Options{}, err
+	}
+	switch opts.MaxSpan {
+	case 0:
+		opts.MaxSpan = DefaultMaxSpan
+	case Zero:
+		opts.MaxSpan = 0
+	}
+	switch opts.MaxFret {
+	case 0:
+		opts.MaxFret = DefaultMaxFret
+	case Zero:
+		opts.MaxFret = 0
+	}
+
+	switch {
+	case opts.MaxSpan < 0:
+		return Options{}, ErrorInvalidOptions("MaxSpan should be 1 or more, or Zero")
+	case opts.MaxFret < 0 || opts.MaxFret > 24:
+		return Options{}, ErrorInvalidOptions("MaxFret should be 1 to 24, or Zero")
+	case opts.MinStrings < 0 || opts.MinStrings > len(opts.Tuning.Strings):
+		return Options{}, ErrorInvalidOptions("MinStrings should be 0 to the number of strings")
+	}
+	for _, tone := range opts.Required {
+		if tone != Root && tone != Third && tone != Fifth && tone != Seventh {
+			return Options{}, ErrorInvalidOptions("unknown tone " + string(tone))
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,209 @@
+package fretboard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{name: "C", opts: Options{}, want: "x32010"},
+		{name: "G", opts: Options{}, want: "320003"},
+		{name: "E", opts: Options{}, want: "022100"},
+		{name: "Am", opts: Options{}, want: "x02210"},
+		{name: "F", opts: Options{NoOpen: true}, want: "133211"},
+		{name: "G7", opts: Options{}, want: "320001"},
+		{name: "C/G", opts: Options{}, want: "332010"},
+		{name: "D/F#", opts: Options{}, want: "200232"},
+		{name: "D", opts: Options{Tuning: DropD}, want: "000232"},
+		{name: "C", opts: Options{Tuning: Ukulele}, want: "0003"},
+		{name: "Am", opts: Options{Tuning: Ukulele}, want: "2000"},
+		{name: "G", opts: Options{Tuning: Ukulele}, want: "0232"},
+		{name: "F", opts: Options{Tuning: Ukulele}, want: "2010"},
+		{name: "Am7", opts: Options{Tuning: Ukulele}, want: "0000"},
+		{name: "G", opts: Options{Tuning: Mandolin}, want: "0023"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" "+test.opts.Tuning.Name, func(t *testing.T) {
+			actual, err := Find(test.name, test.opts)
+			if err != nil {
+				t.Fatalf(`Find(%q) got error: %v`, test.name, err)
+			}
+			if actual[0].String() != test.want {
+				t.Errorf(`Find(%q), actual:%v, want:%q`, test.name, actual[0], test.want)
+			}
+		})
+	}
+}
+
+func TestFindFingering(t *testing.T) {
+	actual, err := Find("F", Options{NoOpen: true})
+	if err != nil {
+		t.Fatalf(`Find("F") got error: %v`, err)
+	}
+
+	want := Fingering{Frets: []int{1, 3, 3, 2, 1, 1}, Notes: []int{41, 48, 53, 57, 60, 65}, Barre: 1, Fingers: 4, Difficulty: 14}
+	if !reflect.DeepEqual(actual[0], want) {
+		t.Errorf(`Find("F"), actual:%+v, want:%+v`, actual[0], want)
+	}
+
+	for i := 1; i < len(actual); i++ {
+		if actual[i].Difficulty < actual[i-1].Difficulty {
+			t.Errorf(`Find("F") should be ranked by difficulty, actual:%v`, actual)
+		}
+	}
+}
+
+func TestFindConstraints(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  Options
+		check func(f Fingering) bool
+	}{
+		{name: "C", opts: Options{MaxSpan: 2}, check: func(f Fingering) bool { return highestPressed(f.Frets)-lowestPressed(f.Frets) <= 2 }},
+		{name: "C", opts: Options{MaxFret: 3}, check: func(f Fingering) bool { return highestPressed(f.Frets) <= 3 }},
+		{name: "Em", opts: Options{MaxFret: Zero, MutedInside: true}, check: func(f Fingering) bool { return highestPressed(f.Frets) == 0 }},
+		{name: "A", opts: Options{MaxSpan: Zero}, check: func(f Fingering) bool { return highestPressed(f.Frets) == lowestPressed(f.Frets) }},
+		{name: "Am7", opts: Options{NoOpen: true}, check: func(f Fingering) bool { return indexOf(f.Frets, 0) < 0 }},
+		{name: "A", opts: Options{MinStrings: 6}, check: func(f Fingering) bool { return indexOf(f.Frets, -1) < 0 }},
+		{name: "C", opts: Options{}, check: func(f Fingering) bool { return f.Notes[0]%12 == 0 }},
+		{name: "C7", opts: Options{Required: []Tone{Root, Third, Seventh}}, check: func(f Fingering) bool {
+			return hasPitchClass(f.Notes, 0) && hasPitchClass(f.Notes, 4) && hasPitchClass(f.Notes, 10)
+		}},
+		// muted strings only at edges by default
+		{name: "D7", opts: Options{}, check: func(f Fingering) bool {
+			for i := 1; i < len(f.Frets)-1; i++ {
+				if f.Frets[i] < 0 && f.Frets[i-1] >= 0 && indexOfAbove(f.Frets, i) {
+					return false
+				}
+			}
+			return true
+		}},
+	}
+
+	for _, test := range tests {
+		actual, err := Find(test.name, test.opts)
+		if err != nil {
+			t.Errorf(`Find(%q, %+v) got error: %v`, test.name, test.opts, err)
+			continue
+		}
+		for _, f := range actual {
+			if !test.check(f) {
+				t.Errorf(`Find(%q, %+v), unexpected fingering:%v`, test.name, test.opts, f)
+				break
+			}
+		}
+	}
+}
+
+func TestFindOptionalFifth(t *testing.T) {
+	actual, err := Find("G7", Options{})
+	if err != nil {
+		t.Fatalf(`Find("G7") got error: %v`, err)
+	}
+
+	found := false
+	for _, f := range actual {
+		if !hasPitchClass(f.Notes, 2) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf(`Find("G7") should have a fingering without the 5th`)
+	}
+}
+
+func TestFindAnyBass(t *testing.T) {
+	actual, err := Find("C", Options{AnyBass: true})
+	if err != nil {
+		t.Fatalf(`Find("C") got error: %v`, err)
+	}
+
+	found := false
+	for _, f := range actual {
+		if f.Notes[0]%12 != 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf(`Find("C") with AnyBass should have an inversion`)
+	}
+}
+
+func TestFindChord(t *testing.T) {
+	c, _ := chord.Parse("Em")
+	actual, err := FindChord(c, Options{Tuning: DADGAD})
+	if err != nil || len(actual) == 0 {
+		t.Fatalf(`FindChord("Em") got error: %v`, err)
+	}
+	for _, n := range actual[0].Notes {
+		if !hasPitchClass([]int{4, 7, 11}, n%12) {
+			t.Errorf(`FindChord("Em"), not a chord tone:%v`, actual[0])
+		}
+	}
+}
+
+func TestFindError(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want error
+	}{
+		{name: "CN7", opts: Options{}, want: chord.ErrNotFoundKind},
+		{name: "C", opts: Options{MaxSpan: -2}, want: ErrInvalidOptions},
+		{name: "C", opts: Options{MaxFret: -2}, want: ErrInvalidOptions},
+		{name: "C", opts: Options{MaxFret: Zero}, want: ErrNotFound},
+		{name: "C", opts: Options{MaxFret: 25}, want: ErrInvalidOptions},
+		{name: "C", opts: Options{MinStrings: 7}, want: ErrInvalidOptions},
+		{name: "C", opts: Options{Required: []Tone{"9th"}}, want: ErrInvalidOptions},
+		{name: "C", opts: Options{Tuning: Tuning{Name: "invalid", Strings: []int{128}}}, want: ErrInvalidTuning},
+		{name: "C", opts: Options{Tuning: Tuning{Name: "one", Strings: []int{48}}}, want: ErrNotFound},
+		{name: "C13", opts: Options{Tuning: Ukulele}, want: ErrNotFound},
+	}
+
+	for _, test := range tests {
+		_, err := Find(test.name, test.opts)
+		if !errors.Is(err, test.want) {
+			t.Errorf(`Find(%q, %+v), actual:"%v", want:"%v"`, test.name, test.opts, err, test.want)
+		}
+	}
+}
+
+func indexOf(list []int, v int) int {
+	for i, n := range list {
+		if n == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// whether a string above `i` sounds
+func indexOfAbove(frets []int, i int) bool {
+	for _, fret := range frets[i+1:] {
+		if fret >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPitchClass(notes []int, pc int) bool {
+	for _, n := range notes {
+		if n%12 == pc {
+			return true
+		}
+	}
+
+	return false
+}
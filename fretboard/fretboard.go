@@ -0,0 +1,135 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Open strings of a fretted instrument as note numbers, in order from the lowest sounding string on a chord chart.
+// A re-entrant tuning like the ukulele keeps its order of strings.
+type Tuning struct {
+	Name    string
+	Strings []int
+}
+
+// Tunings
+var (
+	// E2 A2 D3 G3 B3 E4
+	Standard = Tuning{Name: "standard", Strings: []int{40, 45, 50, 55, 59, 64}}
+	// D2 A2 D3 G3 B3 E4
+	DropD = Tuning{Name: "drop-d", Strings: []int{38, 45, 50, 55, 59, 64}}
+	// D2 A2 D3 G3 A3 D4
+	DADGAD = Tuning{Name: "dadgad", Strings: []int{38, 45, 50, 55, 57, 62}}
+	// G4 C4 E4 A4
+	Ukulele = Tuning{Name: "ukulele", Strings: []int{67, 60, 64, 69}}
+	// E1 A1 D2 G2
+	Bass = Tuning{Name: "bass", Strings: []int{28, 33, 38, 43}}
+	// G3 D4 A4 E5
+	Mandolin = Tuning{Name: "mandolin", Strings: []int{55, 62, 69, 76}}
+)
+
+// All tunings
+var AllTunings = []Tuning{Standard, DropD, DADGAD, Ukulele, Bass, Mandolin}
+
+// A fingering of a chord
+type Fingering struct {
+	// Frets of strings in order of `Tuning.Strings`. `-1` is a muted string, and `0` is an open string.
+	Frets []int
+	// Note numbers of sounding strings in order of strings
+	Notes []int
+	// Fret of the barre by the index finger. `0` if there is no barre.
+	Barre int
+	// Number of fingers to press strings
+	Fingers int
+	// Lower is easier to play
+	Difficulty int
+}
+
+// Sentinel errors
+var (
+	ErrInvalidTuning  = errors.New("Invalid tuning")
+	ErrInvalidOptions = errors.New("Invalid options")
+	ErrNotFound       = errors.New("Not found fingering")
+)
+
+var (
+	ErrorInvalidTuning  = func(t Tuning) error { return fmt.Errorf("%w. %+v", ErrInvalidTuning, t) }
+	ErrorInvalidOptions = func(reason string) error { return fmt.Errorf("%w. %s", ErrInvalidOptions, reason) }
+	ErrorNotFound       = func(chordName string, t Tuning) error {
+		return fmt.Errorf("%w. `%s` on %s", ErrNotFound, chordName, t.Name)
+	}
+)
+
+// Make a custom tuning from note names with octave `"D2", "G2", "D3", "G3", "B3", "D4"`
+func NewTuning(name string, noteNames ...string) (Tuning, error) {
+	t := Tuning{Name: name}
+	for _, noteName := range noteNames {
+		n, err := note.NoteNumber(noteName)
+		if err != nil {
+			return Tuning{}, err
+		}
+		t.Strings = append(t.Strings, n)
+	}
+	if err := t.validate(); err != nil {
+		return Tuning{}, err
+	}
+
+	return t, nil
+}
+
+// Get a tuning by name `drop-d`
+func GetTuning(name string) (Tuning, error) {
+	for _, t := range AllTunings {
+		if t.Name == strings.ToLower(name) {
+			return t, nil
+		}
+	}
+
+	return Tuning{}, ErrorInvalidTuning(Tuning{Name: name})
+}
+
+// Get frets as a chord chart `x32010`. Frets are separated by `-` if any fret is over 9 `x-10-12-12-11-10`.
+func (f Fingering) String() string {
+	var frets []string
+	separator := ""
+	for _, fret := range f.Frets {
+		if fret < 0 {
+			frets = append(frets, "x")
+			continue
+		}
+		if fret > 9 {
+			separator = "-"
+		}
+		frets = append(frets, strconv.Itoa(fret))
+	}
+
+	return strings.Join(frets, separator)
+}
+
+// Whether a string is lower than its previous string, like `Ukulele`
+func (t Tuning) IsReentrant() bool {
+	for i := 1; i < len(t.Strings); i++ {
+		if t.Strings[i] < t.Strings[i-1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t Tuning) validate() error {
+	if len(t.Strings) == 0 {
+		return ErrorInvalidTuning(t)
+	}
+	for _, n := range t.Strings {
+		if n < note.MinimumNoteNumber || n > note.MaximumNoteNumber {
+			return ErrorInvalidTuning(t)
+		}
+	}
+
+	return nil
+}
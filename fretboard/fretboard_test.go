@@ -0,0 +1,64 @@
+package fretboard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewTuning(t *testing.T) {
+	actual, err := NewTuning("open-g", "D2", "G2", "D3", "G3", "B3", "D4")
+	want := Tuning{Name: "open-g", Strings: []int{38, 43, 50, 55, 59, 62}}
+	if err != nil || !reflect.DeepEqual(actual, want) {
+		t.Errorf(`NewTuning, actual:%+v, want:%+v. %v`, actual, want, err)
+	}
+
+	if _, err := NewTuning("empty"); !errors.Is(err, ErrInvalidTuning) {
+		t.Errorf(`NewTuning without strings, actual:"%v", want:"%v"`, err, ErrInvalidTuning)
+	}
+	if _, err := NewTuning("invalid", "X2"); err == nil {
+		t.Errorf(`NewTuning("X2") should be an error`)
+	}
+}
+
+func TestGetTuning(t *testing.T) {
+	for _, want := range AllTunings {
+		actual, err := GetTuning(want.Name)
+		if err != nil || !reflect.DeepEqual(actual, want) {
+			t.Errorf(`GetTuning(%q), actual:%+v, want:%+v. %v`, want.Name, actual, want, err)
+		}
+	}
+
+	if actual, err := GetTuning("DADGAD"); err != nil || actual.Name != "dadgad" {
+		t.Errorf(`GetTuning("DADGAD"), actual:%+v. %v`, actual, err)
+	}
+	if _, err := GetTuning("banjo"); !errors.Is(err, ErrInvalidTuning) {
+		t.Errorf(`GetTuning("banjo"), actual:"%v", want:"%v"`, err, ErrInvalidTuning)
+	}
+}
+
+func TestFingeringString(t *testing.T) {
+	tests := []struct {
+		frets []int
+		want  string
+	}{
+		{frets: []int{-1, 3, 2, 0, 1, 0}, want: "x32010"},
+		{frets: []int{1, 3, 3, 2, 1, 1}, want: "133211"},
+		{frets: []int{-1, 10, 12, 12, 11, 10}, want: "x-10-12-12-11-10"},
+		{frets: []int{0, 0, 0, 3}, want: "0003"},
+	}
+
+	for _, test := range tests {
+		if actual := (Fingering{Frets: test.frets}).String(); actual != test.want {
+			t.Errorf(`String(%v), actual:%q, want:%q`, test.frets, actual, test.want)
+		}
+	}
+}
+
+func TestIsReentrant(t *testing.T) {
+	for _, tuning := range AllTunings {
+		if tuning.IsReentrant() != (tuning.Name == "ukulele") {
+			t.Errorf(`IsReentrant() of %s, actual:%v`, tuning.Name, tuning.IsReentrant())
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Quality of interval
+type Quality string
+
+const (
+	Diminished Quality = "d"
+	Minor      Quality = "m"
+	Perfect    Quality = "P"
+	Major      Quality = "M"
+	Augmented  Quality = "A"
+)
+
+// An interval which has a generic number `9` and a quality `M`, as a major 9th
+type Interval struct {
+	// Generic number. `1` is the unison, `8` is the octave and `9` is the 9th.
+	Number  int
+	Quality Quality
+}
+
+// Semitones of major or perfect simple intervals. Index is `Number - 1`.
+var baseSemitones = [7]int{0, 2, 4, 5, 7, 9, 11}
+
+// Default interval of semitones within an octave. The tritone `6` is the diminished 5th.
+var defaultIntervals = [12]Interval{
+	{1, Perfect}, {2, Minor}, {2, Major}, {3, Minor}, {3, Major}, {4, Perfect},
+	{5, Diminished}, {5, Perfect}, {6, Minor}, {6, Major}, {7, Minor}, {7, Major},
+}
+
+// Sentinel errors. Every error from this package wraps one of them, or an error of `note`.
+var (
+	ErrInvalid             = errors.New("Invalid interval")
+	ErrCouldNotGetInterval = errors.New("Could not get interval")
+	ErrDescending          = errors.New("Descending interval is not available")
+)
+
+var (
+	ErrorInvalidInterval     = func(intervalName string) error { return note.NewParseError(intervalName, 0, ErrInvalid) }
+	ErrorCouldNotGetInterval = func(semitones int, number int) error {
+		return fmt.Errorf("%w. semitones:%d, number:%d", ErrCouldNotGetInterval, semitones, number)
+	}
+	ErrorDescendingInterval = func(from string, to string) error {
+		return fmt.Errorf("%w. `%s` -> `%s`", ErrDescending, from, to)
+	}
+)
+
+// Create an interval from a generic number `3` and a quality `m`
+func New(number int, quality Quality) (Interval, error) {
+	i := Interval{Number: number, Quality: quality}
+	if number < 1 {
+		return Interval{}, ErrorInvalidInterval(i.String())
+	}
+	if _, isExists := qualityOffset(i.isPerfectClass(), quality); !isExists {
+		return Interval{}, ErrorInvalidInterval(i.String())
+	}
+
+	return i, nil
+}
+
+// As interval name i.e. `m3`, `P5`, `M9`, `d5`, or alteration style i.e. `#11`, `b13`, `9`
+var intervalRegexp = regexp.MustCompile(`^(?:([dmPMA])|(bb|b|#)?)([1-9][0-9]?)$`)
+
+// Parse an interval name `m3`, `P5`, or alteration style name `#11`, `b13` to `Interval`.
+// Alteration is relative to major or perfect intervals. `b9` is `m9`, `#11` is `A11` and `b5` is `d5`.
+func Parse(intervalName string) (Interval, error) {
+	re := intervalRegexp.FindStringSubmatch(intervalName)
+	if re == nil {
+		return Interval{}, ErrorInvalidInterval(intervalName)
+	}
+
+	number, _ := strconv.Atoi(re[3])
+	i := Interval{Number: number}
+
+	if re[1] != "" {
+		i.Quality = Quality(re[1])
+	} else {
+		quality, isExists := alterationQuality(i.isPerfectClass(), re[2])
+		if !isExists {
+			return Interval{}, ErrorInvalidInterval(intervalName)
+		}
+		i.Quality = quality
+	}
+
+	if _, err := New(i.Number, i.Quality); err != nil {
+		return Interval{}, ErrorInvalidInterval(intervalName)
+	}
+
+	return i, nil
+}
+
+// Get a default interval from semitones. `3` -> `m3`, `14` -> `M9`, `6` -> `d5`
+func FromSemitones(semitones int) (Interval, error) {
+	if semitones < 0 {
+		return Interval{}, ErrorCouldNotGetInterval(semitones, 0)
+	}
+
+	i := defaultIntervals[semitones%12]
+	i.Number += semitones / 12 * 7
+
+	return i, nil
+}
+
+// Get an interval from semitones and a generic number. `6, 4` -> `A4`, `6, 5` -> `d5`
+func FromSemitonesAndNumber(semitones int, number int) (Interval, error) {
+	if number < 1 {
+		return Interval{}, ErrorCouldNotGetInterval(semitones, number)
+	}
+
+	i := Interval{Number: number}
+	offset := semitones - i.baseSemitones()
+	for _, q := range []Quality{Diminished, Minor, Perfect, Major, Augmented} {
+		if o, isExists := qualityOffset(i.isPerfectClass(), q); isExists && o == offset {
+			i.Quality = q
+			return i, nil
+		}
+	}
+
+	return Interval{}, ErrorCouldNotGetInterval(semitones, number)
+}
+
+// Get an interval between 2 notes. `E`, `C` -> `m6`, `C4`, `D5` -> `M9`
+// If both notes have octave, `to` should not be lower than `from`. Otherwise, it's a simple interval upward.
+func Between(from note.Note, to note.Note) (Interval, error) {
+	number := mod(note.LetterIndex(to.Letter)-note.LetterIndex(from.Letter), 7) + 1
+	i := Interval{Number: number}
+	offset := mod(to.PitchClass()-from.PitchClass()-i.baseSemitones(), 12)
+	if offset > 6 {
+		offset -= 12
+	}
+	semitones := i.baseSemitones() + offset
+
+	if from.HasOctave && to.HasOctave {
+		a, _ := from.MIDI()
+		b, _ := to.MIDI()
+		if b < a {
+			return Interval{}, ErrorDescendingInterval(from.String(), to.String())
+		}
+		octaves := (b - a - semitones) / 12
+		number += octaves * 7
+		semitones += octaves * 12
+	}
+
+	return FromSemitonesAndNumber(semitones, number)
+}
+
+// Get semitones of the interval. `M9` -> `14`. It's `0` for the zero value, which has no generic number.
+func (i Interval) Semitones() int {
+	offset, _ := qualityOffset(i.isPerfectClass(), i.Quality)
+
+	return i.baseSemitones() + offset
+}
+
+// Get an interval name `M9`
+func (i Interval) String() string {
+	return string(i.Quality) + strconv.Itoa(i.Number)
+}
+
+// Get an alteration style name `#11`, `b13` or `9`, as in chord names.
+func (i Interval) Symbol() string {
+	for _, alteration := range []string{"", "b", "bb", "#"} {
+		if q, isExists := alterationQuality(i.isPerfectClass(), alteration); isExists && q == i.Quality {
+			return alteration + strconv.Itoa(i.Number)
+		}
+	}
+
+	return i.String()
+}
+
+// Check the interval is wider than an octave
+func (i Interval) IsCompound() bool {
+	return i.Number > 8
+}
+
+// Get a simple interval of the compound interval. `M9` -> `M2`. The octave `P8` is kept.
+func (i Interval) Simple() Interval {
+	if i.Number > 8 {
+		i.Number = (i.Number-1)%7 + 1
+	}
+
+	return i
+}
+
+// Get a compound interval by adding octaves. `M2`, `1` -> `M9`
+func (i Interval) Compound(octaves int) Interval {
+	i.Number += octaves * 7
+
+	return i
+}
+
+// Get an inverted interval. `M3` -> `m6`, `A4` -> `d5`. A compound interval is inverted as its simple interval.
+// The zero value is kept as it is.
+func (i Interval) Invert() Interval {
+	if i.Number < 1 {
+		return i
+	}
+
+	s := i.Simple()
+	inverted := Interval{Number: 9 - s.Number}
+	switch s.Quality {
+	case Diminished:
+		inverted.Quality = Augmented
+	case Minor:
+		inverted.Quality = Major
+	case Major:
+		inverted.Quality = Minor
+	case Augmented:
+		inverted.Quality = Diminished
+	default:
+		inverted.Quality = Perfect
+	}
+
+	return inverted
+}
+
+// Get a note above `n` by the interval. `C4` + `m3` -> `Eb4`
+func (i Interval) Above(n note.Note) (note.Note, error) {
+	return n.TransposeByInterval(i.Semitones(), i.Number)
+}
+
+// `1`, `4`, `5` and `8` are perfect, others are major or minor.
+func (i Interval) isPerfectClass() bool {
+	if i.Number < 1 {
+		return false
+	}
+
+	switch (i.Number - 1) % 7 {
+	case 0, 3, 4:
+		return true
+	}
+
+	return false
+}
+
+func (i Interval) baseSemitones() int {
+	if i.Number < 1 {
+		return 0
+	}
+
+	return baseSemitones[(i.Number-1)%7] + (i.Number-1)/7*12
+}
+
+// semitones from major or perfect interval by quality
+func qualityOffset(isPerfectClass bool, quality Quality) (int, bool) {
+	offsets := map[Quality]int{Diminished: -2, Minor: -1, Major: 0, Augmented: 1}
+	if isPerfectClass {
+		offsets = map[Quality]int{Diminished: -1, Perfect: 0, Augmented: 1}
+	}
+	offset, isExists := offsets[quality]
+
+	return offset, isExists
+}
+
+// quality from alteration `b` in chord names
+func alterationQuality(isPerfectClass bool, alteration string) (Quality, bool) {
+	qualities := map[string]Quality{"bb": Diminished, "b": Minor, "": Major, "#": Augmented}
+	if isPerfectClass {
+		qualities = map[string]Quality{"b": Diminished, "": Perfect, "#": Augmented}
+	}
+	quality, isExists := qualities[alteration]
+
+	return quality, isExists
+}
+
+func mod(n int, m int) int {
+	return ((n % m) + m) % m
+}
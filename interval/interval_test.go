@@ -0,0 +1,228 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      Interval
+		semitones int
+		symbol    string
+	}{
+		{name: "m3", want: Interval{3, Minor}, semitones: 3, symbol: "b3"},
+		{name: "P5", want: Interval{5, Perfect}, semitones: 7, symbol: "5"},
+		{name: "M9", want: Interval{9, Major}, semitones: 14, symbol: "9"},
+		{name: "A4", want: Interval{4, Augmented}, semitones: 6, symbol: "#4"},
+		{name: "d5", want: Interval{5, Diminished}, semitones: 6, symbol: "b5"},
+		{name: "d7", want: Interval{7, Diminished}, semitones: 9, symbol: "bb7"},
+		{name: "P8", want: Interval{8, Perfect}, semitones: 12, symbol: "8"},
+		{name: "#11", want: Interval{11, Augmented}, semitones: 18, symbol: "#11"},
+		{name: "b13", want: Interval{13, Minor}, semitones: 20, symbol: "b13"},
+		{name: "b9", want: Interval{9, Minor}, semitones: 13, symbol: "b9"},
+		{name: "#9", want: Interval{9, Augmented}, semitones: 15, symbol: "#9"},
+		{name: "13", want: Interval{13, Major}, semitones: 21, symbol: "13"},
+		{name: "bb7", want: Interval{7, Diminished}, semitones: 9, symbol: "bb7"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Parse(test.name)
+			if err != nil {
+				t.Fatalf(`Parse("%v") got error: %v`, test.name, err)
+			}
+			if actual != test.want {
+				t.Errorf(`Parse("%v"), actual:"%v", want:"%v"`, test.name, actual, test.want)
+			}
+			if actual.Semitones() != test.semitones {
+				t.Errorf(`Semitones() of "%v", actual:"%v", want:"%v"`, test.name, actual.Semitones(), test.semitones)
+			}
+			if actual.Symbol() != test.symbol {
+				t.Errorf(`Symbol() of "%v", actual:"%v", want:"%v"`, test.name, actual.Symbol(), test.symbol)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, name := range []string{"", "P3", "M5", "m4", "bb5", "X3", "0", "m0", "#"} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(name)
+			if err == nil || err.Error() != ErrorInvalidInterval(name).Error() {
+				t.Errorf(`Parse("%v"), actual err:"%v", want:"%v"`, name, err, ErrorInvalidInterval(name))
+			}
+		})
+	}
+}
+
+func TestFromSemitones(t *testing.T) {
+	tests := []struct {
+		semitones int
+		want      string
+	}{
+		{semitones: 0, want: "P1"},
+		{semitones: 3, want: "m3"},
+		{semitones: 6, want: "d5"},
+		{semitones: 12, want: "P8"},
+		{semitones: 14, want: "M9"},
+		{semitones: 21, want: "M13"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, _ := FromSemitones(test.semitones)
+			if actual.String() != test.want {
+				t.Errorf(`FromSemitones(%v), actual:"%v", want:"%v"`, test.semitones, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestFromSemitonesAndNumber(t *testing.T) {
+	tests := []struct {
+		semitones int
+		number    int
+		want      string
+	}{
+		{semitones: 6, number: 4, want: "A4"},
+		{semitones: 6, number: 5, want: "d5"},
+		{semitones: 3, number: 2, want: "A2"},
+		{semitones: 18, number: 11, want: "A11"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := FromSemitonesAndNumber(test.semitones, test.number)
+			if err != nil {
+				t.Fatalf(`FromSemitonesAndNumber(%v, %v) got error: %v`, test.semitones, test.number, err)
+			}
+			if actual.String() != test.want {
+				t.Errorf(`FromSemitonesAndNumber(%v, %v), actual:"%v", want:"%v"`, test.semitones, test.number, actual, test.want)
+			}
+		})
+	}
+
+	if _, err := FromSemitonesAndNumber(7, 3); err == nil {
+		t.Error(`FromSemitonesAndNumber(7, 3) should be an error`)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want string
+	}{
+		{from: "E", to: "C", want: "m6"},
+		{from: "C", to: "E", want: "M3"},
+		{from: "C", to: "F#", want: "A4"},
+		{from: "C", to: "Gb", want: "d5"},
+		{from: "C4", to: "D5", want: "M9"},
+		{from: "C4", to: "C5", want: "P8"},
+		{from: "C4", to: "C4", want: "P1"},
+		{from: "B3", to: "C4", want: "m2"},
+		{from: "C4", to: "F#5", want: "A11"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.from+"-"+test.to, func(t *testing.T) {
+			from, _ := note.Parse(test.from)
+			to, _ := note.Parse(test.to)
+			actual, err := Between(from, to)
+			if err != nil {
+				t.Fatalf(`Between("%v", "%v") got error: %v`, test.from, test.to, err)
+			}
+			if actual.String() != test.want {
+				t.Errorf(`Between("%v", "%v"), actual:"%v", want:"%v"`, test.from, test.to, actual, test.want)
+			}
+		})
+	}
+
+	from, _ := note.Parse("C5")
+	to, _ := note.Parse("C4")
+	if _, err := Between(from, to); err == nil || err.Error() != ErrorDescendingInterval("C5", "C4").Error() {
+		t.Errorf(`Between("C5", "C4") wants Error(%v). but got "%v"`, ErrorDescendingInterval("C5", "C4"), err)
+	}
+}
+
+func TestInvertAndCompound(t *testing.T) {
+	tests := []struct {
+		name     string
+		inverted string
+		simple   string
+		compound string
+	}{
+		{name: "M3", inverted: "m6", simple: "M3", compound: "M10"},
+		{name: "A4", inverted: "d5", simple: "A4", compound: "A11"},
+		{name: "P5", inverted: "P4", simple: "P5", compound: "P12"},
+		{name: "P1", inverted: "P8", simple: "P1", compound: "P8"},
+		{name: "m9", inverted: "M7", simple: "m2", compound: "m16"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i, _ := Parse(test.name)
+			if actual := i.Invert().String(); actual != test.inverted {
+				t.Errorf(`Invert() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.inverted)
+			}
+			if actual := i.Simple().String(); actual != test.simple {
+				t.Errorf(`Simple() of "%v", actual:"%v", want:"%v"`, test.name, actual, test.simple)
+			}
+			if actual := i.Compound(1).String(); actual != test.compound {
+				t.Errorf(`Compound(1) of "%v", actual:"%v", want:"%v"`, test.name, actual, test.compound)
+			}
+			if i.IsCompound() != (i.Number > 8) {
+				t.Errorf(`IsCompound() of "%v" is wrong`, test.name)
+			}
+		})
+	}
+}
+
+func TestAbove(t *testing.T) {
+	n, _ := note.Parse("C4")
+	i, _ := Parse("b13")
+	actual, err := i.Above(n)
+	if err != nil || actual.String() != "Ab5" {
+		t.Errorf(`Above("C4") of "b13", actual:"%v", want:"Ab5". %v`, actual, err)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var i Interval
+	if actual := i.Semitones(); actual != 0 {
+		t.Errorf(`Semitones() of the zero value, actual:%v, want:0`, actual)
+	}
+	if actual := i.Invert(); actual != i {
+		t.Errorf(`Invert() of the zero value, actual:"%v", want the zero value`, actual)
+	}
+	n, _ := note.Parse("C4")
+	if _, err := i.Above(n); err == nil {
+		t.Errorf(`Above("C4") of the zero value should be an error`)
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	_, err := Parse("P3")
+	if !errors.Is(err, ErrInvalid) {
+		t.Errorf(`errors.Is(%v, ErrInvalid) should be true`, err)
+	}
+
+	var pe *note.ParseError
+	if !errors.As(err, &pe) || pe.Input != "P3" {
+		t.Errorf(`errors.As(%v) should get *note.ParseError`, err)
+	}
+
+	if _, err := FromSemitonesAndNumber(7, 3); !errors.Is(err, ErrCouldNotGetInterval) {
+		t.Errorf(`errors.Is(%v, ErrCouldNotGetInterval) should be true`, err)
+	}
+
+	from, _ := note.Parse("C5")
+	to, _ := note.Parse("C4")
+	if _, err := Between(from, to); !errors.Is(err, ErrDescending) {
+		t.Errorf(`errors.Is(%v, ErrDescending) should be true`, err)
+	}
+}
@@ -0,0 +1,150 @@
+package midi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Ticks per quarter note by default
+const DefaultDivision = 480
+
+// A Standard MIDI File
+type File struct {
+	// `0` for a single track, `1` for multiple tracks which are played at once
+	Format int
+	// Ticks per quarter note
+	Division int
+	Tracks   []Track
+}
+
+// A track of a Standard MIDI File
+type Track struct {
+	Name           string
+	Tempos         []Tempo
+	TimeSignatures []TimeSignature
+	Notes          []Note
+}
+
+// A note with the tick to start and the duration in ticks
+type Note struct {
+	Number   int
+	Velocity int
+	Channel  int
+	Start    int
+	Duration int
+}
+
+// A tempo change at a tick. BPM is of quarter notes, and it's stored in microseconds per quarter note in a file.
+type Tempo struct {
+	Tick int
+	BPM  float64
+}
+
+// A time signature change at a tick `3/4`. Denominator should be a power of 2.
+type TimeSignature struct {
+	Tick        int
+	Numerator   int
+	Denominator int
+}
+
+// Sentinel errors
+var (
+	ErrInvalidFile  = errors.New("Invalid MIDI file")
+	ErrInvalidEvent = errors.New("Invalid MIDI event")
+)
+
+var (
+	ErrorInvalidFile  = func(reason string) error { return fmt.Errorf("%w. %s", ErrInvalidFile, reason) }
+	ErrorInvalidEvent = func(event interface{}) error { return fmt.Errorf("%w. %+v", ErrInvalidEvent, event) }
+)
+
+// Make a file of format 0 with a track at a tempo `120` in 4/4
+func New(bpm float64, notes []Note) *File {
+	return &File{
+		Format:   0,
+		Division: DefaultDivision,
+		Tracks: []Track{{
+			Tempos:         []Tempo{{Tick: 0, BPM: bpm}},
+			TimeSignatures: []TimeSignature{{Tick: 0, Numerator: 4, Denominator: 4}},
+			Notes:          notes,
+		}},
+	}
+}
+
+// Make notes which are played one after another from note numbers `{60, 62, 64}` i.e. a result of `scale.GetScaleFromRoot()`.
+// Each note is played for `duration` ticks from `start`.
+func Sequence(noteNumbers []int, start int, duration int, velocity int) []Note {
+	var notes []Note
+	for i, n := range noteNumbers {
+		notes = append(notes, Note{Number: n, Velocity: velocity, Start: start + i*duration, Duration: duration})
+	}
+
+	return notes
+}
+
+// Make notes of chords which are played one after another from voicings `{{60, 64, 67}, {59, 62, 67}}`.
+// Each chord is played for `duration` ticks from `start`.
+func Chords(voicings [][]int, start int, duration int, velocity int) []Note {
+	var notes []Note
+	for i, voicing := range voicings {
+		for _, n := range voicing {
+			notes = append(notes, Note{Number: n, Velocity: velocity, Start: start + i*duration, Duration: duration})
+		}
+	}
+
+	return notes
+}
+
+// Get the end tick of a track. It's the end of the last note or the tick of the last meta event.
+func (t Track) End() int {
+	end := 0
+	for _, n := range t.Notes {
+		if n.Start+n.Duration > end {
+			end = n.Start + n.Duration
+		}
+	}
+	for _, tempo := range t.Tempos {
+		if tempo.Tick > end {
+			end = tempo.Tick
+		}
+	}
+	for _, ts := range t.TimeSignatures {
+		if ts.Tick > end {
+			end = ts.Tick
+		}
+	}
+
+	return end
+}
+
+func (n Note) validate() error {
+	if n.Number < note.MinimumNoteNumber || n.Number > note.MaximumNoteNumber ||
+		n.Velocity < 1 || n.Velocity > 127 || n.Channel < 0 || n.Channel > 15 || n.Start < 0 || n.Duration < 1 {
+		return ErrorInvalidEvent(n)
+	}
+
+	return nil
+}
+
+func (t Tempo) validate() error {
+	if t.Tick < 0 || t.BPM <= 0 || microseconds(t.BPM) > 0xFFFFFF {
+		return ErrorInvalidEvent(t)
+	}
+
+	return nil
+}
+
+func (ts TimeSignature) validate() error {
+	if ts.Tick < 0 || ts.Numerator < 1 || ts.Numerator > 255 || ts.Denominator < 1 || ts.Denominator > 128 || ts.Denominator&(ts.Denominator-1) != 0 {
+		return ErrorInvalidEvent(ts)
+	}
+
+	return nil
+}
+
+// microseconds per quarter note of BPM
+func microseconds(bpm float64) int {
+	return int(60000000/bpm + 0.5)
+}
@@ -0,0 +1,72 @@
+package midi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	actual := Sequence([]int{60, 62, 64}, 480, 240, 100)
+	want := []Note{
+		{Number: 60, Velocity: 100, Start: 480, Duration: 240},
+		{Number: 62, Velocity: 100, Start: 720, Duration: 240},
+		{Number: 64, Velocity: 100, Start: 960, Duration: 240},
+	}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Sequence(), actual:"%v", want:"%v"`, actual, want)
+	}
+}
+
+func TestChords(t *testing.T) {
+	actual := Chords([][]int{{60, 64, 67}, {59, 62}}, 0, 1920, 90)
+	want := []Note{
+		{Number: 60, Velocity: 90, Start: 0, Duration: 1920},
+		{Number: 64, Velocity: 90, Start: 0, Duration: 1920},
+		{Number: 67, Velocity: 90, Start: 0, Duration: 1920},
+		{Number: 59, Velocity: 90, Start: 1920, Duration: 1920},
+		{Number: 62, Velocity: 90, Start: 1920, Duration: 1920},
+	}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Chords(), actual:"%v", want:"%v"`, actual, want)
+	}
+}
+
+func TestEnd(t *testing.T) {
+	track := Track{
+		Tempos: []Tempo{{Tick: 5000, BPM: 90}},
+		Notes:  Sequence([]int{60, 62}, 0, 480, 100),
+	}
+	if actual := track.End(); actual != 5000 {
+		t.Errorf(`End(), actual:"%v", want:"5000"`, actual)
+	}
+
+	track.Notes = append(track.Notes, Note{Number: 60, Velocity: 1, Start: 4800, Duration: 480})
+	if actual := track.End(); actual != 5280 {
+		t.Errorf(`End(), actual:"%v", want:"5280"`, actual)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []interface{ validate() error }{
+		Note{Number: 128, Velocity: 100, Duration: 1},
+		Note{Number: 60, Velocity: 0, Duration: 1},
+		Note{Number: 60, Velocity: 100, Duration: 0},
+		Note{Number: 60, Velocity: 100, Duration: 1, Channel: 16},
+		Note{Number: 60, Velocity: 100, Duration: 1, Start: -1},
+		Tempo{BPM: 0},
+		Tempo{BPM: 1},
+		TimeSignature{Numerator: 3, Denominator: 3},
+		TimeSignature{Numerator: 0, Denominator: 4},
+	}
+
+	for _, e := range invalid {
+		if err := e.validate(); !errors.Is(err, ErrInvalidEvent) {
+			t.Errorf(`validate() of "%+v" wants Error(%v). but got "%v"`, e, ErrInvalidEvent, err)
+		}
+	}
+
+	if err := (TimeSignature{Numerator: 6, Denominator: 8}).validate(); err != nil {
+		t.Errorf(`validate() of 6/8 got error: %v`, err)
+	}
+}
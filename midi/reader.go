@@ -0,0 +1,223 @@
+package midi
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+)
+
+// Read a Standard MIDI File of format 0 or 1. Only notes, the track name, tempos and time signatures are read, and other events are skipped.
+// Notes are in order of the start and the note number. A note which isn't turned off is ended at the end of its track.
+func Read(r io.Reader) (*File, error) {
+	br := bufio.NewReader(r)
+
+	id, data, err := readChunk(br)
+	if err != nil {
+		return nil, err
+	}
+	if id != "MThd" || len(data) < 6 {
+		return nil, ErrorInvalidFile("no header")
+	}
+	f := &File{
+		Format:   int(binary.BigEndian.Uint16(data[0:2])),
+		Division: int(binary.BigEndian.Uint16(data[4:6])),
+	}
+	if f.Format != 0 && f.Format != 1 {
+		return nil, ErrorInvalidFile("format should be 0 or 1")
+	}
+	if f.Division&0x8000 != 0 {
+		return nil, ErrorInvalidFile("SMPTE division is not supported")
+	}
+
+	for count := int(binary.BigEndian.Uint16(data[2:4])); len(f.Tracks) < count; {
+		id, data, err := readChunk(br)
+		if err != nil {
+			return nil, err
+		}
+		if id != "MTrk" {
+			continue // unknown chunk
+		}
+		t, err := readTrack(data)
+		if err != nil {
+			return nil, err
+		}
+		f.Tracks = append(f.Tracks, t)
+	}
+
+	return f, nil
+}
+
+func readChunk(r io.Reader) (string, []byte, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return "", nil, ErrorInvalidFile("could not read chunk")
+	}
+
+	// not to allocate the length in the header at once, which can be up to 4GB
+	length := int64(binary.BigEndian.Uint32(header[4:]))
+	data, err := io.ReadAll(io.LimitReader(r, length))
+	if err != nil || int64(len(data)) < length {
+		return "", nil, ErrorInvalidFile("chunk is too short")
+	}
+
+	return string(header[:4]), data, nil
+}
+
+type trackReader struct {
+	data []byte
+	pos  int
+}
+
+func (tr *trackReader) byte() (byte, error) {
+	if tr.pos >= len(tr.data) {
+		return 0, ErrorInvalidFile("track is too short")
+	}
+	b := tr.data[tr.pos]
+	tr.pos++
+
+	return b, nil
+}
+
+func (tr *trackReader) bytes(n int) ([]byte, error) {
+	if n < 0 || tr.pos+n > len(tr.data) {
+		return nil, ErrorInvalidFile("track is too short")
+	}
+	b := tr.data[tr.pos : tr.pos+n]
+	tr.pos += n
+
+	return b, nil
+}
+
+func (tr *trackReader) vlq() (int, error) {
+	n := 0
+	for i := 0; i < 4; i++ {
+		b, err := tr.byte()
+		if err != nil {
+			return 0, err
+		}
+		n = n<<7 | int(b&0x7F)
+		if b&0x80 == 0 {
+			return n, nil
+		}
+	}
+
+	return 0, ErrorInvalidFile("too long variable-length quantity")
+}
+
+func readTrack(data []byte) (Track, error) {
+	t := Track{}
+	tr := &trackReader{data: data}
+	// notes which are on, by channel and note number
+	on := map[[2]int][]Note{}
+	tick := 0
+	var status byte
+
+	for tr.pos < len(tr.data) {
+		delta, err := tr.vlq()
+		if err != nil {
+			return Track{}, err
+		}
+		tick += delta
+
+		b, err := tr.byte()
+		if err != nil {
+			return Track{}, err
+		}
+		if b < 0x80 {
+			// running status
+			if status == 0 {
+				return Track{}, ErrorInvalidFile("no status")
+			}
+			tr.pos--
+		} else {
+			status = b
+		}
+
+		switch {
+		case status == 0xFF:
+			metaType, err := tr.byte()
+			if err != nil {
+				return Track{}, err
+			}
+			length, err := tr.vlq()
+			if err != nil {
+				return Track{}, err
+			}
+			body, err := tr.bytes(length)
+			if err != nil {
+				return Track{}, err
+			}
+			status = 0
+			switch {
+			case metaType == 0x03:
+				t.Name = string(body)
+			case metaType == 0x51 && length == 3:
+				us := int(body[0])<<16 | int(body[1])<<8 | int(body[2])
+				if us == 0 {
+					return Track{}, ErrorInvalidFile("tempo should not be 0")
+				}
+				t.Tempos = append(t.Tempos, Tempo{Tick: tick, BPM: math.Round(60000000/float64(us)*1000) / 1000})
+			case metaType == 0x58 && length == 4:
+				t.TimeSignatures = append(t.TimeSignatures, TimeSignature{Tick: tick, Numerator: int(body[0]), Denominator: 1 << body[1]})
+			case metaType == 0x2F:
+				endNotes(&t, on, tick)
+				return t, nil
+			}
+		case status == 0xF0 || status == 0xF7:
+			length, err := tr.vlq()
+			if err != nil {
+				return Track{}, err
+			}
+			if _, err := tr.bytes(length); err != nil {
+				return Track{}, err
+			}
+			status = 0
+		default:
+			size := 2
+			if status&0xF0 == 0xC0 || status&0xF0 == 0xD0 {
+				size = 1
+			}
+			params, err := tr.bytes(size)
+			if err != nil {
+				return Track{}, err
+			}
+			channel := int(status & 0x0F)
+			switch {
+			case status&0xF0 == 0x90 && params[1] > 0:
+				key := [2]int{channel, int(params[0])}
+				on[key] = append(on[key], Note{Number: int(params[0]), Velocity: int(params[1]), Channel: channel, Start: tick})
+			case status&0xF0 == 0x80 || status&0xF0 == 0x90:
+				key := [2]int{channel, int(params[0])}
+				if len(on[key]) > 0 {
+					n := on[key][0]
+					on[key] = on[key][1:]
+					n.Duration = tick - n.Start
+					t.Notes = append(t.Notes, n)
+				}
+			}
+		}
+	}
+
+	endNotes(&t, on, tick)
+
+	return t, nil
+}
+
+// end notes which are still on at the tick, and sort notes in order of the start and the note number
+func endNotes(t *Track, on map[[2]int][]Note, tick int) {
+	for _, notes := range on {
+		for _, n := range notes {
+			n.Duration = tick - n.Start
+			t.Notes = append(t.Notes, n)
+		}
+	}
+
+	sort.SliceStable(t.Notes, func(i, j int) bool {
+		if t.Notes[i].Start != t.Notes[j].Start {
+			return t.Notes[i].Start < t.Notes[j].Start
+		}
+		return t.Notes[i].Number < t.Notes[j].Number
+	})
+}
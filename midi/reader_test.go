@@ -0,0 +1,111 @@
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+func TestReadRoundTrip(t *testing.T) {
+	run, _ := scale.GetScaleFromRoot("ionian", "D4")
+	c, _ := chord.Parse("CM7")
+	voicing := []int{48 + c.RootPitchClass}
+	for _, n := range c.Intervals[1:] {
+		voicing = append(voicing, voicing[0]+n)
+	}
+
+	f := &File{
+		Format:   1,
+		Division: 480,
+		Tracks: []Track{
+			{
+				Name:           "conductor",
+				Tempos:         []Tempo{{Tick: 0, BPM: 96}, {Tick: 1920, BPM: 140.5}},
+				TimeSignatures: []TimeSignature{{Tick: 0, Numerator: 6, Denominator: 8}},
+			},
+			{Name: "scale", Notes: Sequence(run, 0, 240, 80)},
+			{Name: "chords", Notes: append(Chords([][]int{voicing, voicing}, 0, 960, 100), Note{Number: 36, Velocity: 127, Channel: 9, Start: 0, Duration: 1})},
+		},
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		t.Fatalf(`Bytes() got error: %v`, err)
+	}
+	actual, err := Read(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf(`Read() got error: %v`, err)
+	}
+
+	// notes are sorted by the start and the note number
+	want := *f
+	want.Tracks = append([]Track{}, f.Tracks...)
+	want.Tracks[2].Notes = []Note{
+		{Number: 36, Velocity: 127, Channel: 9, Start: 0, Duration: 1},
+		{Number: 48, Velocity: 100, Start: 0, Duration: 960},
+		{Number: 52, Velocity: 100, Start: 0, Duration: 960},
+		{Number: 55, Velocity: 100, Start: 0, Duration: 960},
+		{Number: 59, Velocity: 100, Start: 0, Duration: 960},
+		{Number: 48, Velocity: 100, Start: 960, Duration: 960},
+		{Number: 52, Velocity: 100, Start: 960, Duration: 960},
+		{Number: 55, Velocity: 100, Start: 960, Duration: 960},
+		{Number: 59, Velocity: 100, Start: 960, Duration: 960},
+	}
+	if !reflect.DeepEqual(*actual, want) {
+		t.Errorf(`Read(), actual:"%+v", want:"%+v"`, *actual, want)
+	}
+}
+
+func TestReadRunningStatus(t *testing.T) {
+	b := []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96,
+		'M', 'T', 'r', 'k', 0, 0, 0, 21,
+		0, 0x90, 60, 100,
+		0, 64, 90, // running status
+		0, 0xC0, 5, // program change is skipped
+		96, 0x90, 60, 0, // note on with velocity 0 is note off
+		0, 64, 0,
+		0, 0xF0, 1, 0xF7, // sysex is skipped
+	}
+
+	f, err := Read(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf(`Read() got error: %v`, err)
+	}
+	want := []Note{
+		{Number: 60, Velocity: 100, Start: 0, Duration: 96},
+		{Number: 64, Velocity: 90, Start: 0, Duration: 96},
+	}
+	if !reflect.DeepEqual(f.Tracks[0].Notes, want) {
+		t.Errorf(`Read(), actual:"%v", want:"%v"`, f.Tracks[0].Notes, want)
+	}
+}
+
+func TestReadError(t *testing.T) {
+	tests := []struct {
+		title string
+		b     []byte
+	}{
+		{title: "empty", b: nil},
+		{title: "no header", b: []byte{'M', 'T', 'r', 'k', 0, 0, 0, 0}},
+		{title: "format 2", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 2, 0, 1, 0, 96}},
+		{title: "SMPTE", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0xE7, 0x28}},
+		{title: "no track", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96}},
+		{title: "short track", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0, 3, 0, 0x90, 60}},
+		{title: "no status", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0, 3, 0, 60, 100}},
+		{title: "huge chunk", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0xFF, 0xFF, 0xFF, 0xFF, 0, 0xFF, 0x2F, 0}},
+		{title: "tempo 0", b: []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0, 7, 0, 0xFF, 0x51, 3, 0, 0, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			if _, err := Read(bytes.NewReader(test.b)); !errors.Is(err, ErrInvalidFile) {
+				t.Errorf(`Read() wants Error(%v). but got "%v"`, ErrInvalidFile, err)
+			}
+		})
+	}
+}
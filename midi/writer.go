@@ -0,0 +1,155 @@
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+)
+
+// order of events on the same tick
+const (
+	orderName = iota
+	orderMeta
+	orderNoteOff
+	orderNoteOn
+)
+
+type event struct {
+	tick  int
+	order int
+	data  []byte
+}
+
+// Write the file as a Standard MIDI File
+func (f *File) Write(w io.Writer) error {
+	b, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+
+	return err
+}
+
+// Get the file as bytes of a Standard MIDI File
+func (f *File) Bytes() ([]byte, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("MThd")
+	writeUint32(&buf, 6)
+	writeUint16(&buf, f.Format)
+	writeUint16(&buf, len(f.Tracks))
+	writeUint16(&buf, f.Division)
+
+	for _, t := range f.Tracks {
+		data, err := t.bytes()
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("MTrk")
+		writeUint32(&buf, len(data))
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (f *File) validate() error {
+	switch {
+	case f.Format != 0 && f.Format != 1:
+		return ErrorInvalidFile("format should be 0 or 1")
+	case f.Format == 0 && len(f.Tracks) != 1:
+		return ErrorInvalidFile("format 0 should have 1 track")
+	case len(f.Tracks) == 0:
+		return ErrorInvalidFile("no track")
+	case f.Division < 1 || f.Division > 0x7FFF:
+		return ErrorInvalidFile("division should be 1 to 32767")
+	}
+
+	return nil
+}
+
+// encode a track into events with delta times and the end of track
+func (t Track) bytes() ([]byte, error) {
+	var events []event
+	if t.Name != "" {
+		events = append(events, event{tick: 0, order: orderName, data: meta(0x03, []byte(t.Name))})
+	}
+	for _, tempo := range t.Tempos {
+		if err := tempo.validate(); err != nil {
+			return nil, err
+		}
+		us := microseconds(tempo.BPM)
+		events = append(events, event{tick: tempo.Tick, order: orderMeta, data: meta(0x51, []byte{byte(us >> 16), byte(us >> 8), byte(us)})})
+	}
+	for _, ts := range t.TimeSignatures {
+		if err := ts.validate(); err != nil {
+			return nil, err
+		}
+		power := 0
+		for d := ts.Denominator; d > 1; d >>= 1 {
+			power++
+		}
+		events = append(events, event{tick: ts.Tick, order: orderMeta, data: meta(0x58, []byte{byte(ts.Numerator), byte(power), 24, 8})})
+	}
+	for _, n := range t.Notes {
+		if err := n.validate(); err != nil {
+			return nil, err
+		}
+		events = append(events,
+			event{tick: n.Start, order: orderNoteOn, data: []byte{0x90 | byte(n.Channel), byte(n.Number), byte(n.Velocity)}},
+			event{tick: n.Start + n.Duration, order: orderNoteOff, data: []byte{0x80 | byte(n.Channel), byte(n.Number), 0}},
+		)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].tick != events[j].tick {
+			return events[i].tick < events[j].tick
+		}
+		return events[i].order < events[j].order
+	})
+
+	var buf bytes.Buffer
+	last := 0
+	for _, e := range events {
+		buf.Write(vlq(e.tick - last))
+		buf.Write(e.data)
+		last = e.tick
+	}
+	buf.Write(vlq(0))
+	buf.Write(meta(0x2F, nil))
+
+	return buf.Bytes(), nil
+}
+
+// a meta event of a type with data
+func meta(metaType byte, data []byte) []byte {
+	return append(append([]byte{0xFF, metaType}, vlq(len(data))...), data...)
+}
+
+// variable-length quantity
+func vlq(n int) []byte {
+	b := []byte{byte(n & 0x7F)}
+	for n >>= 7; n > 0; n >>= 7 {
+		b = append([]byte{byte(n&0x7F) | 0x80}, b...)
+	}
+
+	return b
+}
+
+func writeUint32(buf *bytes.Buffer, n int) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(n))
+	buf.Write(b[:])
+}
+
+func writeUint16(buf *bytes.Buffer, n int) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(n))
+	buf.Write(b[:])
+}
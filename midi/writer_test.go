@@ -0,0 +1,90 @@
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	f := &File{
+		Format:   0,
+		Division: 96,
+		Tracks: []Track{{
+			Tempos:         []Tempo{{Tick: 0, BPM: 120}},
+			TimeSignatures: []TimeSignature{{Tick: 0, Numerator: 3, Denominator: 4}},
+			Notes:          []Note{{Number: 60, Velocity: 100, Start: 0, Duration: 200}},
+		}},
+	}
+
+	actual, err := f.Bytes()
+	if err != nil {
+		t.Fatalf(`Bytes() got error: %v`, err)
+	}
+	want := []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96,
+		'M', 'T', 'r', 'k', 0, 0, 0, 28,
+		0, 0xFF, 0x51, 3, 0x07, 0xA1, 0x20, // 500000 microseconds
+		0, 0xFF, 0x58, 4, 3, 2, 24, 8,
+		0, 0x90, 60, 100,
+		0x81, 0x48, 0x80, 60, 0, // 200 ticks later
+		0, 0xFF, 0x2F, 0,
+	}
+	if !bytes.Equal(actual, want) {
+		t.Errorf(`Bytes(), actual:"% X", want:"% X"`, actual, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(120, Sequence([]int{60, 62, 64}, 0, 480, 100))
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf(`Write() got error: %v`, err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("MThd")) {
+		t.Errorf(`Write() should write a header. "% X"`, buf.Bytes())
+	}
+}
+
+func TestVLQ(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{n: 0, want: []byte{0x00}},
+		{n: 0x7F, want: []byte{0x7F}},
+		{n: 0x80, want: []byte{0x81, 0x00}},
+		{n: 0x3FFF, want: []byte{0xFF, 0x7F}},
+		{n: 0x0FFFFFFF, want: []byte{0xFF, 0xFF, 0xFF, 0x7F}},
+	}
+
+	for _, test := range tests {
+		if actual := vlq(test.n); !bytes.Equal(actual, test.want) {
+			t.Errorf(`vlq(%v), actual:"% X", want:"% X"`, test.n, actual, test.want)
+		}
+	}
+}
+
+func TestBytesError(t *testing.T) {
+	track := Track{Notes: Sequence([]int{60}, 0, 480, 100)}
+	tests := []struct {
+		title string
+		file  *File
+		want  error
+	}{
+		{title: "format 2", file: &File{Format: 2, Division: 480, Tracks: []Track{track}}, want: ErrInvalidFile},
+		{title: "format 0 with 2 tracks", file: &File{Format: 0, Division: 480, Tracks: []Track{track, track}}, want: ErrInvalidFile},
+		{title: "no track", file: &File{Format: 1, Division: 480}, want: ErrInvalidFile},
+		{title: "division 0", file: &File{Format: 0, Division: 0, Tracks: []Track{track}}, want: ErrInvalidFile},
+		{title: "note 128", file: New(120, Sequence([]int{128}, 0, 480, 100)), want: ErrInvalidEvent},
+		{title: "tempo 0", file: New(0, nil), want: ErrInvalidEvent},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			if _, err := test.file.Bytes(); !errors.Is(err, test.want) {
+				t.Errorf(`Bytes() wants Error(%v). but got "%v"`, test.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package nashville
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// A chord on a Nashville number chart
+type Chord struct {
+	// Number of the root `5`, `b7`
+	Number string
+	// Kind of chord as written `m7`. `-` and `-7` are read as `m` and `m7`.
+	Kind string
+	// Number of the bass of a slash chord `7`. It's empty if it's not a slash chord.
+	Bass string
+	// Whole note let ring, written in a diamond `<1>`
+	Diamond bool
+	// Anticipated, written with `^` before the number `^4`
+	Push bool
+	// Full chord name in the key `G/B`
+	Symbol string
+	// Notes of the chord by `chord.GetChord()`. The bass comes first on a slash chord.
+	Notes []string
+}
+
+// A bar of a chart. A split bar has several chords.
+type Bar []Chord
+
+// A chart of bars
+type Chart []Bar
+
+// Sentinel errors. Errors from this package wrap one of them, or an error of `note` or `chord`.
+var ErrInvalid = errors.New("Invalid Nashville number")
+
+var (
+	ErrorInvalidNumber = func(number string, pos int) error { return note.NewParseError(number, pos, ErrInvalid) }
+	// The cause `err` i.e. `chord.ErrNotFoundKind` also matches `errors.Is`
+	ErrorInvalidNumberWithError = func(number string, pos int, err error) error {
+		return &note.ParseError{Input: number, Pos: pos, Reason: ErrInvalid.Error(), Err: causedError{sentinel: ErrInvalid, cause: err}}
+	}
+)
+
+// A sentinel error with the cause. `errors.Is` matches both.
+type causedError struct {
+	sentinel error
+	cause    error
+}
+
+func (e causedError) Error() string {
+	return fmt.Sprintf("%v, %v", e.sentinel, e.cause)
+}
+
+func (e causedError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+func (e causedError) Unwrap() error {
+	return e.cause
+}
+
+var regexpNumber = regexp.MustCompile(`^([b#]?[1-7])([^/]*)(?:/([b#]?[1-7]))?$`)
+
+// Parse a Nashville number chart `1 4 5/7 6m` in a key `C` -> `C`, `F`, `G/B`, `Am`.
+// Each number is a bar, and numbers in parentheses `(1 4)` are a split bar. `|` is a bar line which is ignored.
+// A key ends with `m` for minor `Am`, then numbers are on the natural minor scale.
+func Parse(chart string, key string) (Chart, error) {
+	tonic, isMinor, err := note.ParseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var bars Chart
+	var split Bar
+	inSplit := false
+	for _, t := range tokenize(chart) {
+		switch t.text {
+		case "(":
+			if inSplit {
+				return nil, ErrorInvalidNumber(t.text, t.pos)
+			}
+			inSplit = true
+			continue
+		case ")":
+			if !inSplit || len(split) == 0 {
+				return nil, ErrorInvalidNumber(t.text, t.pos)
+			}
+			bars = append(bars, split)
+			split, inSplit = nil, false
+			continue
+		case "|":
+			continue
+		}
+
+		c, err := parseNumber(t.text, t.pos, tonic, isMinor)
+		if err != nil {
+			return nil, err
+		}
+		if inSplit {
+			split = append(split, c)
+		} else {
+			bars = append(bars, Bar{c})
+		}
+	}
+	if inSplit {
+		return nil, ErrorInvalidNumber("(", strings.LastIndex(chart, "("))
+	}
+
+	return bars, nil
+}
+
+// Get chord names of each bar
+func (c Chart) Symbols() [][]string {
+	var symbols [][]string
+	for _, bar := range c {
+		var s []string
+		for _, ch := range bar {
+			s = append(s, ch.Symbol)
+		}
+		symbols = append(symbols, s)
+	}
+
+	return symbols
+}
+
+// Get the chart as text `1 4 (5/7 6m) <1>`
+func (c Chart) String() string {
+	var bars []string
+	for _, bar := range c {
+		var numbers []string
+		for _, ch := range bar {
+			numbers = append(numbers, ch.String())
+		}
+		if len(numbers) > 1 {
+			bars = append(bars, "("+strings.Join(numbers, " ")+")")
+		} else {
+			bars = append(bars, numbers...)
+		}
+	}
+
+	return strings.Join(bars, " ")
+}
+
+// Get the chord as a Nashville number `^5/7`
+func (c Chord) String() string {
+	s := c.Number + c.Kind
+	if c.Bass != "" {
+		s += "/" + c.Bass
+	}
+	if c.Diamond {
+		s = "<" + s + ">"
+	}
+	if c.Push {
+		s = "^" + s
+	}
+
+	return s
+}
+
+// Get a Nashville number chart from chord names of each bar `{{"C"}, {"F", "G/B"}}` in a key `C` -> `1 (4 5/7)`
+func Format(bars [][]string, key string) (string, error) {
+	var chart Chart
+	for _, symbols := range bars {
+		var bar Bar
+		for _, symbol := range symbols {
+			c, err := fromChordName(symbol, key)
+			if err != nil {
+				return "", err
+			}
+			bar = append(bar, c)
+		}
+		chart = append(chart, bar)
+	}
+
+	return chart.String(), nil
+}
+
+// Get a Nashville number `5/7` of a full chord name `G/B` in a key `C`
+func Number(chordName string, key string) (string, error) {
+	c, err := fromChordName(chordName, key)
+	if err != nil {
+		return "", err
+	}
+
+	return c.String(), nil
+}
+
+// get a chord on a chart from a full chord name in a key. The kind of chord is canonical `7b5` for `7(b5)`.
+func fromChordName(chordName string, key string) (Chord, error) {
+	tonic, isMinor, err := note.ParseKey(key)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	c, err := chord.Parse(chordName)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	root, _ := note.Parse(c.Root)
+	nc := Chord{Number: numberOf(root, tonic, isMinor), Symbol: c.String(), Notes: c.Notes()}
+	if c.Kind != "base" {
+		nc.Kind = c.Kind
+	}
+	if c.Bass != "" {
+		bass, _ := note.Parse(c.Bass)
+		nc.Bass = numberOf(bass, tonic, isMinor)
+	}
+
+	return nc, nil
+}
+
+type token struct {
+	text string
+	pos  int
+}
+
+// split a chart into numbers, parentheses and bar lines with their byte offsets
+func tokenize(chart string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range chart {
+		isSpace := r == ' ' || r == '\t' || r == '\n' || r == '\r'
+		isMark := r == '(' || r == ')' || r == '|'
+		if (isSpace || isMark) && start >= 0 {
+			tokens = append(tokens, token{text: chart[start:i], pos: start})
+			start = -1
+		}
+		if isMark {
+			tokens = append(tokens, token{text: string(r), pos: i})
+		} else if !isSpace && start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{text: chart[start:], pos: start})
+	}
+
+	return tokens
+}
+
+// parse a number `^<6m7/5>` at `pos` of the chart
+func parseNumber(text string, pos int, tonic note.Note, isMinor bool) (Chord, error) {
+	c := Chord{}
+	body := text
+	if strings.HasPrefix(body, "^") {
+		c.Push = true
+		body = body[1:]
+	}
+	if strings.HasPrefix(body, "<") && strings.HasSuffix(body, ">") {
+		c.Diamond = true
+		body = body[1 : len(body)-1]
+	}
+
+	re := regexpNumber.FindStringSubmatch(body)
+	if re == nil {
+		return Chord{}, ErrorInvalidNumber(text, pos)
+	}
+	c.Number, c.Kind, c.Bass = re[1], re[2], re[3]
+	if c.Kind == "-" || c.Kind == "-7" {
+		c.Kind = "m" + c.Kind[1:]
+	}
+
+	root, err := degreeNote(c.Number, tonic, isMinor)
+	if err != nil {
+		return Chord{}, err
+	}
+	c.Symbol = root.String() + c.Kind
+	if c.Bass != "" {
+		bass, err := degreeNote(c.Bass, tonic, isMinor)
+		if err != nil {
+			return Chord{}, err
+		}
+		c.Symbol += "/" + bass.String()
+	}
+
+	notes, err := chord.GetChord(c.Symbol)
+	if err != nil {
+		return Chord{}, ErrorInvalidNumberWithError(text, pos, err)
+	}
+	c.Notes = notes
+
+	return c, nil
+}
+
+// get a note of a number `b7` in a key
+func degreeNote(number string, tonic note.Note, isMinor bool) (note.Note, error) {
+	accidental := 0
+	switch number[0] {
+	case 'b':
+		accidental = -1
+	case '#':
+		accidental = 1
+	}
+
+	return note.DegreeNote(tonic, isMinor, int(number[len(number)-1]-'0'), accidental)
+}
+
+// get a number `b7` of a note in a key. A double accidental is on the next degree, so `C#` in `Gb` is `5`, not `##4`.
+func numberOf(n note.Note, tonic note.Note, isMinor bool) string {
+	degree, shift := note.DegreeOf(n, tonic, isMinor)
+	for shift > 1 || shift < -1 {
+		if shift > 0 {
+			degree = degree%7 + 1
+		} else {
+			degree = (degree+5)%7 + 1
+		}
+		d, _ := note.DegreeNote(tonic, isMinor, degree, 0)
+		shift = note.PitchClassOf(n.PitchClass() - d.PitchClass())
+		if shift > 6 {
+			shift -= 12
+		}
+	}
+
+	return note.AccidentalName(shift) + string(rune('0'+degree))
+}
@@ -0,0 +1,171 @@
+package nashville
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		chart string
+		key   string
+		want  [][]string
+		text  string
+	}{
+		{chart: "1 4 5/7 6m", key: "C", want: [][]string{{"C"}, {"F"}, {"G/B"}, {"Am"}}, text: "1 4 5/7 6m"},
+		{chart: "| 1 | (2m7 5) | ^<1> |", key: "Eb", want: [][]string{{"Eb"}, {"Fm7", "Bb"}, {"Eb"}}, text: "1 (2m7 5) ^<1>"},
+		{chart: "6-7 b7 4/1", key: "G", want: [][]string{{"Em7"}, {"F"}, {"C/G"}}, text: "6m7 b7 4/1"},
+		{chart: "1m 4m 5 6", key: "Am", want: [][]string{{"Am"}, {"Dm"}, {"E"}, {"F"}}, text: "1m 4m 5 6"},
+		{chart: "1 b3 #4m7b5", key: "F", want: [][]string{{"F"}, {"Ab"}, {"Bm7b5"}}, text: "1 b3 #4m7b5"},
+		{chart: "", key: "C", want: nil, text: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chart, func(t *testing.T) {
+			chart, err := Parse(test.chart, test.key)
+			if err != nil {
+				t.Fatalf(`Parse("%v", "%v") got error: %v`, test.chart, test.key, err)
+			}
+			if actual := chart.Symbols(); !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Parse("%v", "%v"), actual:"%v", want:"%v"`, test.chart, test.key, actual, test.want)
+			}
+			if actual := chart.String(); actual != test.text {
+				t.Errorf(`String() of "%v", actual:"%v", want:"%v"`, test.chart, actual, test.text)
+			}
+		})
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	chart, _ := Parse("^4 <5/7>", "C")
+	push, diamond := chart[0][0], chart[1][0]
+	if !push.Push || push.Diamond || push.Number != "4" {
+		t.Errorf(`"^4" should be pushed. "%+v"`, push)
+	}
+	if diamond.Push || !diamond.Diamond || diamond.Bass != "7" || !reflect.DeepEqual(diamond.Notes, []string{"B", "D", "G"}) {
+		t.Errorf(`"<5/7>" should be a diamond. "%+v"`, diamond)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		chart string
+		input string
+		pos   int
+	}{
+		{chart: "1 8", input: "8", pos: 2},
+		{chart: "1 4N7", input: "4N7", pos: 2},
+		{chart: "1 (4 5", input: "(", pos: 2},
+		{chart: "1 ((4 5))", input: "(", pos: 3},
+		{chart: "1 ) 4", input: ")", pos: 2},
+		{chart: "1 () 4", input: ")", pos: 3},
+		{chart: "1 5/9", input: "5/9", pos: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chart, func(t *testing.T) {
+			_, err := Parse(test.chart, "C")
+			var pe *note.ParseError
+			if !errors.Is(err, ErrInvalid) || !errors.As(err, &pe) || pe.Input != test.input || pe.Pos != test.pos {
+				t.Errorf(`Parse("%v") wants Error(%v) at %v. but got "%v"`, test.chart, ErrorInvalidNumber(test.input, test.pos), test.pos, err)
+			}
+		})
+	}
+
+	if _, err := Parse("1 4N7", "C"); !errors.Is(err, ErrInvalid) || !errors.Is(err, chord.ErrNotFoundKind) {
+		t.Errorf(`Parse("1 4N7") wants Error(%v) caused by %v. but got "%v"`, ErrInvalid, chord.ErrNotFoundKind, err)
+	}
+
+	if _, err := Parse("1", "X"); !errors.Is(err, note.ErrNotFound) {
+		t.Errorf(`Parse() in "X" wants Error(%v). but got "%v"`, note.ErrNotFound, err)
+	}
+}
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		chordName string
+		key       string
+		want      string
+	}{
+		{chordName: "G/B", key: "C", want: "5/7"},
+		{chordName: "Dm7", key: "C", want: "2m7"},
+		{chordName: "Bb7", key: "C", want: "b77"},
+		{chordName: "F#m7(b5)", key: "C", want: "#4m7b5"},
+		{chordName: "Bm7b5", key: "Am", want: "2m7b5"},
+		{chordName: "Db", key: "Eb", want: "b7"},
+		{chordName: "C#", key: "Gb", want: "5"},
+		{chordName: "Fb7", key: "D", want: "27"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chordName, func(t *testing.T) {
+			actual, err := Number(test.chordName, test.key)
+			if err != nil || actual != test.want {
+				t.Errorf(`Number("%v", "%v"), actual:"%v", want:"%v". %v`, test.chordName, test.key, actual, test.want, err)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	bars := [][]string{{"C"}, {"F", "G/B"}, {"Am7"}}
+	actual, err := Format(bars, "C")
+	if err != nil || actual != "1 (4 5/7) 6m7" {
+		t.Errorf(`Format(%v, "C"), actual:"%v", want:"1 (4 5/7) 6m7". %v`, bars, actual, err)
+	}
+
+	// back and forth
+	chart, _ := Parse(actual, "C")
+	if !reflect.DeepEqual(chart.Symbols(), bars) {
+		t.Errorf(`Parse("%v", "C"), actual:"%v", want:"%v"`, actual, chart.Symbols(), bars)
+	}
+
+	// enharmonic chords are read back as the same notes, without double accidentals
+	for _, key := range []string{"Gb", "C#", "Ebm"} {
+		bars := [][]string{{"C#", "Gb/Bb"}, {"Fb7", "A#m"}, {"E/G#"}}
+		formatted, err := Format(bars, key)
+		if err != nil {
+			t.Fatalf(`Format(%v, "%v") got error: %v`, bars, key, err)
+		}
+		chart, err := Parse(formatted, key)
+		if err != nil {
+			t.Fatalf(`Parse("%v", "%v") got error: %v`, formatted, key, err)
+		}
+		for i, bar := range chart {
+			for j, c := range bar {
+				if !reflect.DeepEqual(pitchClasses(c.Notes), chordPitchClasses(t, bars[i][j])) {
+					t.Errorf(`Format and Parse of "%v" in "%v", actual:"%v"`, bars[i][j], key, c.Notes)
+				}
+			}
+		}
+	}
+
+	if _, err := Format([][]string{{"CN7"}}, "C"); err == nil {
+		t.Error(`Format() of "CN7" should be an error`)
+	}
+}
+
+func chordPitchClasses(t *testing.T, chordName string) []int {
+	t.Helper()
+
+	notes, err := chord.GetChord(chordName)
+	if err != nil {
+		t.Fatalf(`GetChord("%v") got error: %v`, chordName, err)
+	}
+
+	return pitchClasses(notes)
+}
+
+func pitchClasses(noteNames []string) []int {
+	var pcs []int
+	for _, name := range noteNames {
+		n, _ := note.NoteNumber(name)
+		pcs = append(pcs, n%12)
+	}
+
+	return pcs
+}
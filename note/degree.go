@@ -0,0 +1,64 @@
+package note
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Relative note numbers of degrees of the major key and the natural minor key
+var keyDegrees = map[bool][7]int{false: letterDegree, true: {0, 2, 3, 5, 7, 8, 10}}
+
+// Get an index of `Letters` from a letter `D` -> `1`. `H` is treated as `B`. It's `-1` for an unknown letter.
+func LetterIndex(letter string) int {
+	if letter == "H" {
+		letter = "B"
+	}
+	for i, l := range Letters {
+		if l == letter {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Get a pitch class 0 to 11 of a note number or of an interval. `63` -> `3`, `-1` -> `11`
+func PitchClassOf(noteNumber int) int {
+	return ((noteNumber % 12) + 12) % 12
+}
+
+// Get an accidental `bb` from semitones `-2`. It's empty for `0`.
+func AccidentalName(accidental int) string {
+	if accidental > 0 {
+		return strings.Repeat("#", accidental)
+	}
+
+	return strings.Repeat("b", -accidental)
+}
+
+// Get a note on a degree `7` of a key `C`, moved by semitones `-1` -> `Bb`. The minor key is the natural minor.
+// It's spelled with flats in a flat key or on a lowered degree, i.e. `bVII` or `b7` in Roman numerals or Nashville numbers.
+func DegreeNote(tonic Note, isMinor bool, degree int, accidental int) (Note, error) {
+	if degree < 1 || degree > len(Letters) {
+		return Note{}, ErrorCouldNotGetDegree(strconv.Itoa(degree))
+	}
+
+	n, err := tonic.TransposeByInterval(keyDegrees[isMinor][degree-1]+accidental, degree)
+	if err != nil {
+		return Note{}, err
+	}
+
+	return n.Simplify(accidental < 0 || IsFlatKey(tonic, isMinor)), nil
+}
+
+// Get a degree `7` of a note `Bb` in a key `C` by letters, and semitones from the note of the degree in the key `-1`.
+// The semitones are within -5 to 6. It's the reverse of `DegreeNote()`.
+func DegreeOf(n Note, tonic Note, isMinor bool) (int, int) {
+	degree := (LetterIndex(n.Letter)-LetterIndex(tonic.Letter)+7)%7 + 1
+	shift := PitchClassOf(n.PitchClass() - tonic.PitchClass() - keyDegrees[isMinor][degree-1])
+	if shift > 6 {
+		shift -= 12
+	}
+
+	return degree, shift
+}
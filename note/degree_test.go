@@ -0,0 +1,70 @@
+package note
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLetterIndex(t *testing.T) {
+	tests := map[string]int{"C": 0, "D": 1, "B": 6, "H": 6, "X": -1, "": -1}
+	for letter, want := range tests {
+		if actual := LetterIndex(letter); actual != want {
+			t.Errorf(`LetterIndex("%v"), actual:%v, want:%v`, letter, actual, want)
+		}
+	}
+}
+
+func TestPitchClassOf(t *testing.T) {
+	tests := map[int]int{0: 0, 63: 3, 127: 7, -1: 11, -12: 0, 23: 11}
+	for n, want := range tests {
+		if actual := PitchClassOf(n); actual != want {
+			t.Errorf(`PitchClassOf(%v), actual:%v, want:%v`, n, actual, want)
+		}
+	}
+}
+
+func TestAccidentalName(t *testing.T) {
+	tests := map[int]string{0: "", 1: "#", 2: "##", -1: "b", -2: "bb"}
+	for accidental, want := range tests {
+		if actual := AccidentalName(accidental); actual != want {
+			t.Errorf(`AccidentalName(%v), actual:"%v", want:"%v"`, accidental, actual, want)
+		}
+	}
+}
+
+func TestDegreeNote(t *testing.T) {
+	tests := []struct {
+		key        string
+		degree     int
+		accidental int
+		want       string
+	}{
+		{key: "C", degree: 5, accidental: 0, want: "G"},
+		{key: "C", degree: 7, accidental: -1, want: "Bb"},
+		{key: "C", degree: 4, accidental: 1, want: "F#"},
+		{key: "Eb", degree: 3, accidental: 0, want: "G"},
+		{key: "Am", degree: 3, accidental: 0, want: "C"},
+		{key: "Am", degree: 7, accidental: 1, want: "G#"},
+		{key: "Dm", degree: 6, accidental: 0, want: "Bb"},
+		{key: "F#", degree: 7, accidental: 0, want: "E#"},
+	}
+
+	for _, test := range tests {
+		tonic, isMinor, _ := ParseKey(test.key)
+		actual, err := DegreeNote(tonic, isMinor, test.degree, test.accidental)
+		if err != nil || actual.String() != test.want {
+			t.Errorf(`DegreeNote("%v", %v, %v), actual:"%v", want:"%v". %v`, test.key, test.degree, test.accidental, actual, test.want, err)
+			continue
+		}
+
+		degree, shift := DegreeOf(actual, tonic, isMinor)
+		if degree != test.degree || shift != test.accidental {
+			t.Errorf(`DegreeOf("%v") in "%v", actual:%v %v, want:%v %v`, actual, test.key, degree, shift, test.degree, test.accidental)
+		}
+	}
+
+	tonic, _, _ := ParseKey("C")
+	if _, err := DegreeNote(tonic, false, 8, 0); !errors.Is(err, ErrCouldNotGetDegree) {
+		t.Errorf(`DegreeNote() on the 8th, actual error:%v`, err)
+	}
+}
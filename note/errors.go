@@ -0,0 +1,63 @@
+package note
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors. Every error from this package wraps one of them, so `errors.Is(err, note.ErrNotFound)` works.
+var (
+	ErrNotFound          = errors.New("Not found note")
+	ErrCouldNotGetOctave = errors.New("Could not get octave")
+	ErrCouldNotGetDegree = errors.New("Could not get degree")
+	ErrNotFoundOctave    = errors.New("Not found octave")
+	ErrCouldNotSpell     = errors.New("Could not spell note")
+	ErrInvalidOctave     = errors.New("`octave` should be -1 to 9.")
+	ErrOutOfRange        = errors.New("Out of range.")
+	ErrInvalidFrequency  = errors.New("Invalid frequency")
+)
+
+// An error on parsing a name i.e. a note name, a chord name or a scale name.
+// `errors.As` gets it from an error of `note`, `chord`, `scale` and `interval`.
+type ParseError struct {
+	// The part of the name which could not be parsed `N7`
+	Input string
+	// Byte offset of `Input` in the whole name. `1` for `N7` in `CN7`
+	Pos int
+	// Why it could not be parsed `Not found chord Kind`
+	Reason string
+	// The sentinel error which is wrapped
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s. `%s`", e.Reason, e.Input)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Make a `ParseError` of `input` at `pos`, which wraps a sentinel error `err`. The reason is the message of `err`.
+func NewParseError(input string, pos int, err error) error {
+	return &ParseError{Input: input, Pos: pos, Reason: err.Error(), Err: err}
+}
+
+// Errors with the offending input. They are kept as functions for compatibility.
+var (
+	ErrorNotFoundNote               = func(noteName string) error { return NewParseError(noteName, 0, ErrNotFound) }
+	ErrorCouldNotGetOctave          = func(noteName string) error { return NewParseError(noteName, 0, ErrCouldNotGetOctave) }
+	ErrorCouldNotGetOctaveWithError = func(noteName string, err error) error {
+		return &ParseError{Input: noteName, Reason: ErrCouldNotGetOctave.Error(), Err: fmt.Errorf("%w, `%v`", ErrCouldNotGetOctave, err)}
+	}
+	ErrorCouldNotGetDegree = func(noteName string) error { return NewParseError(noteName, 0, ErrCouldNotGetDegree) }
+	ErrorNotFoundOctave    = func(noteName string) error { return fmt.Errorf("%w. `%s`", ErrNotFoundOctave, noteName) }
+	ErrorCouldNotSpell     = func(rootName string, semitones int, degree int) error {
+		return fmt.Errorf("%w. `%s`, semitones:%d, degree:%d", ErrCouldNotSpell, rootName, semitones, degree)
+	}
+
+	ErrorInvalidFrequency = func(frequency float64) error { return fmt.Errorf("%w. %v", ErrInvalidFrequency, frequency) }
+
+	ErrorInvalidOctave = ErrInvalidOctave
+	ErrorOutOfRange    = ErrOutOfRange
+)
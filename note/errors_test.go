@@ -0,0 +1,51 @@
+package note
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorsIs(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "NoteNumber", err: func() error { _, err := NoteNumber("I9"); return err }(), want: ErrNotFound},
+		{name: "NoteNumber range", err: func() error { _, err := NoteNumber("A9"); return err }(), want: ErrOutOfRange},
+		{name: "NoteNumberWithOctave", err: func() error { _, err := NoteNumberWithOctave("C", 10); return err }(), want: ErrInvalidOctave},
+		{name: "Parse", err: func() error { _, err := Parse("C+"); return err }(), want: ErrNotFound},
+		{name: "MIDI", err: func() error { _, err := Note{Letter: "C"}.MIDI(); return err }(), want: ErrNotFoundOctave},
+		{name: "SpellNote", err: func() error { _, err := SpellNote("C", 7, 3); return err }(), want: ErrCouldNotSpell},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !errors.Is(test.err, test.want) {
+				t.Errorf(`errors.Is(%v, %v) should be true`, test.err, test.want)
+			}
+		})
+	}
+
+	if errors.Is(ErrorNotFoundNote("X"), ErrOutOfRange) {
+		t.Error(`ErrorNotFoundNote("X") should not be ErrOutOfRange`)
+	}
+	if ErrorOutOfRange != ErrOutOfRange || ErrorInvalidOctave != ErrInvalidOctave {
+		t.Error(`Old error variables should be the same as sentinels`)
+	}
+}
+
+func TestParseErrorType(t *testing.T) {
+	_, err := NoteNumber("C-5")
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf(`errors.As(%v) should get *ParseError`, err)
+	}
+	if pe.Input != "C-5" || pe.Pos != 0 || pe.Reason != "Not found note" || pe.Err != ErrNotFound {
+		t.Errorf(`ParseError is wrong. "%+v"`, pe)
+	}
+	if pe.Error() != "Not found note. `C-5`" {
+		t.Errorf(`ParseError message is wrong. "%v"`, pe.Error())
+	}
+}
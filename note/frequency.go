@@ -0,0 +1,82 @@
+package note
+
+import (
+	"math"
+)
+
+// Pitch reference of 12 tone equal temperament
+type Reference struct {
+	// Frequency of `A4` (note number 69) in Hz
+	A4 float64
+	// Offset in cents for every note
+	Cents float64
+}
+
+// Common references
+var (
+	A440 = Reference{A4: 440}
+	A442 = Reference{A4: 442}
+	A432 = Reference{A4: 432}
+)
+
+const noteNumberA4 = 69
+
+// Get a frequency in Hz `440` from a note number `69` on `A440`
+func Frequency(noteNumber int) (float64, error) {
+	return A440.Frequency(noteNumber)
+}
+
+// Get a frequency in Hz `261.63` from a note name with octave number `C4` on `A440`
+func FrequencyOf(noteName string) (float64, error) {
+	return A440.FrequencyOf(noteName)
+}
+
+// Get the nearest note number `69` and the deviation in cents `-3.9` from a frequency in Hz `439` on `A440`
+func NearestNote(frequency float64) (int, float64, error) {
+	return A440.Nearest(frequency)
+}
+
+// Get a frequency in Hz from a note number on the reference
+func (r Reference) Frequency(noteNumber int) (float64, error) {
+	if r.A4 <= 0 {
+		return 0, ErrorInvalidFrequency(r.A4)
+	}
+	if noteNumber < MinimumNoteNumber || noteNumber > MaximumNoteNumber {
+		return 0, ErrorOutOfRange
+	}
+
+	return r.A4 * math.Pow(2, (float64(noteNumber-noteNumberA4)+r.Cents/100)/12), nil
+}
+
+// Get a frequency in Hz from a note name with octave number `C4` on the reference. The octave number is needed.
+func (r Reference) FrequencyOf(noteName string) (float64, error) {
+	n, err := Parse(noteName)
+	if err != nil {
+		return 0, err
+	}
+	noteNumber, err := n.MIDI()
+	if err != nil {
+		return 0, err
+	}
+
+	return r.Frequency(noteNumber)
+}
+
+// Get the nearest note number and the deviation in cents -50 to 50 from a frequency in Hz on the reference.
+// It's an error if the nearest note is out of `MinimumNoteNumber` to `MaximumNoteNumber`.
+func (r Reference) Nearest(frequency float64) (int, float64, error) {
+	if r.A4 <= 0 {
+		return 0, 0, ErrorInvalidFrequency(r.A4)
+	}
+	if frequency <= 0 || math.IsInf(frequency, 0) || math.IsNaN(frequency) {
+		return 0, 0, ErrorInvalidFrequency(frequency)
+	}
+
+	semitones := 12*math.Log2(frequency/r.A4) + noteNumberA4 - r.Cents/100
+	noteNumber := math.Round(semitones)
+	if noteNumber < MinimumNoteNumber || noteNumber > MaximumNoteNumber {
+		return 0, 0, ErrorOutOfRange
+	}
+
+	return int(noteNumber), (semitones - noteNumber) * 100, nil
+}
@@ -0,0 +1,112 @@
+package note
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFrequency(t *testing.T) {
+	tests := []struct {
+		reference  Reference
+		noteNumber int
+		want       float64
+	}{
+		{reference: A440, noteNumber: 69, want: 440},
+		{reference: A440, noteNumber: 60, want: 261.6256},
+		{reference: A440, noteNumber: 81, want: 880},
+		{reference: A440, noteNumber: 0, want: 8.1758},
+		{reference: A440, noteNumber: 127, want: 12543.8540},
+		{reference: A442, noteNumber: 69, want: 442},
+		{reference: A432, noteNumber: 57, want: 216},
+		{reference: Reference{A4: 440, Cents: 100}, noteNumber: 68, want: 440},
+		{reference: Reference{A4: 440, Cents: -50}, noteNumber: 69, want: 427.4741},
+	}
+
+	for _, test := range tests {
+		actual, err := test.reference.Frequency(test.noteNumber)
+		if err != nil || math.Abs(actual-test.want) > 0.0001 {
+			t.Errorf(`Frequency(%v) on %v, actual:"%v", want:"%v". %v`, test.noteNumber, test.reference, actual, test.want, err)
+		}
+	}
+
+	if actual, _ := Frequency(69); actual != 440 {
+		t.Errorf(`Frequency(69), actual:"%v", want:"440"`, actual)
+	}
+}
+
+func TestFrequencyOf(t *testing.T) {
+	tests := []struct {
+		noteName string
+		want     float64
+	}{
+		{noteName: "A4", want: 440},
+		{noteName: "C4", want: 261.6256},
+		{noteName: "B#4", want: 261.6256},
+		{noteName: "Bbb4", want: 440},
+		{noteName: "C-1", want: 8.1758},
+	}
+
+	for _, test := range tests {
+		actual, err := FrequencyOf(test.noteName)
+		if err != nil || math.Abs(actual-test.want) > 0.0001 {
+			t.Errorf(`FrequencyOf("%v"), actual:"%v", want:"%v". %v`, test.noteName, actual, test.want, err)
+		}
+	}
+
+	if actual, _ := A442.FrequencyOf("A5"); actual != 884 {
+		t.Errorf(`FrequencyOf("A5") on A442, actual:"%v", want:"884"`, actual)
+	}
+}
+
+func TestNearestNote(t *testing.T) {
+	tests := []struct {
+		reference  Reference
+		frequency  float64
+		noteNumber int
+		cents      float64
+	}{
+		{reference: A440, frequency: 440, noteNumber: 69, cents: 0},
+		{reference: A440, frequency: 439, noteNumber: 69, cents: -3.9389},
+		{reference: A440, frequency: 261.63, noteNumber: 60, cents: 0.0286},
+		{reference: A440, frequency: 452.8929, noteNumber: 69, cents: 50},
+		{reference: A442, frequency: 440, noteNumber: 69, cents: -7.8514},
+		{reference: Reference{A4: 440, Cents: 100}, frequency: 440, noteNumber: 68, cents: 0},
+	}
+
+	for _, test := range tests {
+		noteNumber, cents, err := test.reference.Nearest(test.frequency)
+		if err != nil || noteNumber != test.noteNumber || math.Abs(cents-test.cents) > 0.001 {
+			t.Errorf(`Nearest(%v) on %v, actual:"%v, %v", want:"%v, %v". %v`, test.frequency, test.reference, noteNumber, cents, test.noteNumber, test.cents, err)
+		}
+	}
+
+	if noteNumber, _, _ := NearestNote(880); noteNumber != 81 {
+		t.Errorf(`NearestNote(880), actual:"%v", want:"81"`, noteNumber)
+	}
+}
+
+func TestFrequencyError(t *testing.T) {
+	if _, err := Frequency(128); err != ErrorOutOfRange {
+		t.Errorf(`Frequency(128) wants Error(%v). but got "%v"`, ErrorOutOfRange, err)
+	}
+	if _, err := (Reference{}).Frequency(69); !errors.Is(err, ErrInvalidFrequency) {
+		t.Errorf(`Frequency(69) on A4 0Hz wants Error(%v). but got "%v"`, ErrInvalidFrequency, err)
+	}
+	if _, err := FrequencyOf("A"); !errors.Is(err, ErrNotFoundOctave) {
+		t.Errorf(`FrequencyOf("A") wants Error(%v). but got "%v"`, ErrNotFoundOctave, err)
+	}
+	if _, err := FrequencyOf("X4"); !errors.Is(err, ErrNotFound) {
+		t.Errorf(`FrequencyOf("X4") wants Error(%v). but got "%v"`, ErrNotFound, err)
+	}
+	for _, frequency := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		if _, _, err := NearestNote(frequency); !errors.Is(err, ErrInvalidFrequency) {
+			t.Errorf(`NearestNote(%v) wants Error(%v). but got "%v"`, frequency, ErrInvalidFrequency, err)
+		}
+	}
+	for _, frequency := range []float64{1, 20000} {
+		if _, _, err := NearestNote(frequency); err != ErrorOutOfRange {
+			t.Errorf(`NearestNote(%v) wants Error(%v). but got "%v"`, frequency, ErrorOutOfRange, err)
+		}
+	}
+}
@@ -1,13 +1,15 @@
 package note
 
 import (
-	"fmt"
 	"regexp"
 	"strconv"
 )
 
 var BaseTones = [12]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
 
+// Base tones spelled with flats
+var FlatTones = [12]string{"C", "Db", "D", "Eb", "E", "F", "Gb", "G", "Ab", "A", "Bb", "B"}
+
 //     1   3       6   8   10
 // |  | | | |  |  | | | | | |  |
 // |  |_| |_|  |  |_| |_| |_|  |
@@ -45,16 +47,6 @@ const (
 
 const ErrorInt = -2147483648
 
-var (
-	ErrorNotFoundNote = func(noteName string) error { return fmt.Errorf("Not found note. `%s`", noteName) }
-	ErrorCouldNotGetOctave = func(noteName string) error { return fmt.Errorf("Could not get octave. `%s`", noteName) }
-	ErrorCouldNotGetOctaveWithError = func(noteName string, err error) error { return fmt.Errorf("Could not get octave. `%s`, `%w`", noteName, err) }
-	ErrorCouldNotGetDegree = func(noteName string) error { return fmt.Errorf("Could not get degree. `%s`", noteName) }
-
-	ErrorInvalidOctave = fmt.Errorf("`octave` should be -1 to 9.")
-	ErrorOutOfRange = fmt.Errorf("Out of range.")
-)
-
 // Get a note number `3` from a note name `Eb`. Or, Get a note number `60` from a note name with octave number `C4`.
 func NoteNumber(noteName string) (int, error) {
 	if degree, isExists := noteNameDegree[noteName]; isExists {
@@ -151,6 +143,15 @@ func NoteNumberWithOctave(noteName string, octave int) (int, error) {
 	return noteNumber, nil
 }
 
+// Get a note name with octave number `C#4` from a note number `61`. A black key is spelled with a sharp as `BaseTones`.
+func NoteName(noteNumber int) (string, error) {
+	if noteNumber < MinimumNoteNumber || noteNumber > MaximumNoteNumber {
+		return "", ErrorOutOfRange
+	}
+
+	return BaseTones[noteNumber % 12] + strconv.Itoa(noteNumber / 12 - 1), nil
+}
+
 // get an actual valid note number. 4, degree:0 (C) -> 60
 func actualNoteNumber(octave int, degree int) (int, error) {
 	noteNumber := (octave + 1) * 12 + degree
@@ -203,4 +203,33 @@ func TestNoteNumberErrorWithOctave(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestNoteName(t *testing.T) {
+	tests := []struct {
+		noteNumber int
+		want string
+	}{
+		{noteNumber: 0, want: "C-1"},
+		{noteNumber: 61, want: "C#4"},
+		{noteNumber: 69, want: "A4"},
+		{noteNumber: 127, want: "G9"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := NoteName(test.noteNumber)
+			if err != nil || actual != test.want {
+				t.Errorf(`NoteName(%v), actual:"%v", want:"%v". %v`, test.noteNumber, actual, test.want, err)
+			}
+			if n, _ := NoteNumber(actual); n != test.noteNumber {
+				t.Errorf(`NoteNumber("%v"), actual:"%v", want:"%v"`, actual, n, test.noteNumber)
+			}
+		})
+	}
+
+	for _, noteNumber := range []int{-1, 128} {
+		if _, err := NoteName(noteNumber); err != ErrorOutOfRange {
+			t.Errorf(`NoteName(%v) wants Error(%v). but got "%v"`, noteNumber, ErrorOutOfRange, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package note
+
+// Natural note letters in order
+var Letters = [7]string{"C", "D", "E", "F", "G", "A", "B"}
+
+// Relative note number of each natural letter in `Letters`
+var letterDegree = [7]int{0, 2, 4, 5, 7, 9, 11}
+
+// Spell a note name `Eb` from a root note name `C`, a distance in semitones `3` and a degree `3` (the 3rd).
+// The letter comes from the degree, and the accidental makes up the distance. i.e. `C, 9, 7` -> `Bbb`
+func SpellNote(rootName string, semitones int, degree int) (string, error) {
+	if _, isExists := noteNameDegree[rootName]; !isExists {
+		return "", ErrorNotFoundNote(rootName)
+	}
+
+	root, _ := Parse(rootName)
+	spelled, err := root.TransposeByInterval(semitones, degree)
+	if err != nil {
+		return "", ErrorCouldNotSpell(rootName, semitones, degree)
+	}
+
+	return spelled.String(), nil
+}
@@ -0,0 +1,59 @@
+package note
+
+import "testing"
+
+func TestSpellNote(t *testing.T) {
+	tests := []struct {
+		root      string
+		semitones int
+		degree    int
+		want      string
+	}{
+		{root: "C", semitones: 3, degree: 3, want: "Eb"},
+		{root: "C", semitones: 3, degree: 2, want: "D#"},
+		{root: "C", semitones: 9, degree: 7, want: "Bbb"},
+		{root: "Eb", semitones: 7, degree: 5, want: "Bb"},
+		{root: "Gb", semitones: 4, degree: 3, want: "Bb"},
+		{root: "B", semitones: 11, degree: 7, want: "A#"},
+		{root: "G#", semitones: 8, degree: 5, want: "D##"},
+		{root: "F", semitones: 18, degree: 4, want: "B"},
+		{root: "H", semitones: 4, degree: 3, want: "D#"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			actual, err := SpellNote(test.root, test.semitones, test.degree)
+			if err != nil {
+				t.Fatalf(`SpellNote("%v", %v, %v) got error: %v`, test.root, test.semitones, test.degree, err)
+			}
+			if actual != test.want {
+				t.Errorf(`SpellNote("%v", %v, %v), actual:"%v", want:"%v"`, test.root, test.semitones, test.degree, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestSpellNoteError(t *testing.T) {
+	tests := []struct {
+		root      string
+		semitones int
+		degree    int
+		want      error
+	}{
+		{root: "X", semitones: 4, degree: 3, want: ErrorNotFoundNote("X")},
+		{root: "C", semitones: 7, degree: 3, want: ErrorCouldNotSpell("C", 7, 3)},
+		{root: "C", semitones: 4, degree: 0, want: ErrorCouldNotSpell("C", 4, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.root, func(t *testing.T) {
+			actual, err := SpellNote(test.root, test.semitones, test.degree)
+			if actual != "" {
+				t.Errorf(`SpellNote("%v", %v, %v) got "%v"`, test.root, test.semitones, test.degree, actual)
+			}
+			if err == nil || err.Error() != test.want.Error() {
+				t.Errorf(`SpellNote("%v", %v, %v), actual err:"%v", want:"%v"`, test.root, test.semitones, test.degree, err, test.want)
+			}
+		})
+	}
+}
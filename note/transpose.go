@@ -0,0 +1,119 @@
+package note
+
+import (
+	"strings"
+)
+
+// Transpose note names `{"C4", "E4", "G4"}` by semitones `2` -> `{"D4", "F#4", "A4"}`.
+// Flat notes are spelled with flats, and others are spelled with sharps. See `Note.Transpose()`.
+func TransposeNotes(noteNames []string, semitones int) ([]string, error) {
+	var transposed []string
+	for _, name := range noteNames {
+		n, err := Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		t, err := n.Transpose(semitones)
+		if err != nil {
+			return nil, err
+		}
+		transposed = append(transposed, t.String())
+	}
+
+	return transposed, nil
+}
+
+// Transpose note names from a key `C` to a key `Eb`. `{"C4", "E4", "G4"}` -> `{"Eb4", "G4", "Bb4"}`
+// Each note keeps its spelling relative to the key. See `Note.TransposeToKey()`.
+func TransposeNotesToKey(noteNames []string, fromKey string, toKey string) ([]string, error) {
+	var transposed []string
+	for _, name := range noteNames {
+		n, err := Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		t, err := n.TransposeToKey(fromKey, toKey)
+		if err != nil {
+			return nil, err
+		}
+		transposed = append(transposed, t.String())
+	}
+
+	return transposed, nil
+}
+
+// Transpose the note from a key `Bb` to a key `D`. `Eb4` -> `G4`
+// The key is a note name, and `m` at the end is for a minor key `Dm`. It moves to the nearer way, up to 6 semitones.
+// The letter moves as the key moves, and a double accidental is simplified in the spelling of the key.
+func (n Note) TransposeToKey(fromKey string, toKey string) (Note, error) {
+	from, _, err := ParseKey(fromKey)
+	if err != nil {
+		return Note{}, err
+	}
+	to, isMinor, err := ParseKey(toKey)
+	if err != nil {
+		return Note{}, err
+	}
+
+	semitones := PitchClassOf(to.PitchClass() - from.PitchClass())
+	if semitones > 6 {
+		semitones -= 12
+	}
+	degree := ((LetterIndex(to.Letter)-LetterIndex(from.Letter))%7+7)%7 + 1
+
+	t, err := n.TransposeByInterval(semitones, degree)
+	if err != nil {
+		// a triple accidental, or out of range
+		moved, err := n.Transpose(semitones)
+		if err != nil {
+			return Note{}, err
+		}
+		t = fromPitchClass(moved.PitchClass(), IsFlatKey(to, isMinor))
+		t.Octave, t.HasOctave = moved.Octave, moved.HasOctave
+	}
+
+	return t.Simplify(IsFlatKey(to, isMinor)), nil
+}
+
+// Simplify a double accidental. `Bbb4` -> `A4`, `F##` -> `G`
+// If the pitch is on a black key, it's spelled with a flat when `preferFlat` is true, otherwise with a sharp.
+func (n Note) Simplify(preferFlat bool) Note {
+	if n.Accidental >= -1 && n.Accidental <= 1 {
+		return n
+	}
+
+	s := fromPitchClass(n.PitchClass(), preferFlat)
+	if n.HasOctave {
+		midi, _ := n.MIDI()
+		s.HasOctave = true
+		s.Octave = (midi-s.PitchClass())/12 - 1
+	}
+
+	return s
+}
+
+// Check the key `F`, `Bb` or `Dm` is written with flats
+func IsFlatKey(key Note, isMinor bool) bool {
+	if key.Accidental < 0 {
+		return true
+	}
+	if key.Accidental > 0 {
+		return false
+	}
+	if isMinor {
+		return key.Letter == "D" || key.Letter == "G" || key.Letter == "C" || key.Letter == "F"
+	}
+
+	return key.Letter == "F"
+}
+
+// Parse a key `Eb` or `Cm` to the tonic note and whether it's minor or not.
+func ParseKey(key string) (Note, bool, error) {
+	isMinor := strings.HasSuffix(key, "m")
+	n, err := Parse(strings.TrimSuffix(key, "m"))
+	if err != nil || n.HasOctave {
+		return Note{}, false, ErrorNotFoundNote(key)
+	}
+
+	return n, isMinor, nil
+}
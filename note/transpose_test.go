@@ -0,0 +1,127 @@
+package note
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransposeNotes(t *testing.T) {
+	actual, err := TransposeNotes([]string{"C4", "E4", "G4"}, 2)
+	if err != nil || !reflect.DeepEqual(actual, []string{"D4", "F#4", "A4"}) {
+		t.Errorf(`TransposeNotes(), actual:"%v", err:"%v"`, actual, err)
+	}
+
+	actual, err = TransposeNotes([]string{"Bb", "D", "F"}, 1)
+	if err != nil || !reflect.DeepEqual(actual, []string{"B", "D#", "F#"}) {
+		t.Errorf(`TransposeNotes(), actual:"%v", err:"%v"`, actual, err)
+	}
+
+	if _, err := TransposeNotes([]string{"C4", "G9"}, 1); err != ErrorOutOfRange {
+		t.Errorf(`TransposeNotes() wants Error(%v). but got "%v"`, ErrorOutOfRange, err)
+	}
+	if _, err := TransposeNotes([]string{"X4"}, 1); err == nil || err.Error() != ErrorNotFoundNote("X4").Error() {
+		t.Errorf(`TransposeNotes() wants Error(%v). but got "%v"`, ErrorNotFoundNote("X4"), err)
+	}
+}
+
+func TestTransposeNotesToKey(t *testing.T) {
+	tests := []struct {
+		notes []string
+		from  string
+		to    string
+		want  []string
+	}{
+		{
+			notes: []string{"C4", "E4", "G4"},
+			from:  "C", to: "Eb",
+			want: []string{"Eb4", "G4", "Bb4"},
+		},
+		{
+			notes: []string{"C4", "E4", "G4"},
+			from:  "C", to: "B",
+			want: []string{"B3", "D#4", "F#4"},
+		},
+		{
+			notes: []string{"F#", "A#", "C#"},
+			from:  "D", to: "Bb",
+			want: []string{"D", "F#", "A"},
+		},
+		{
+			notes: []string{"Ab"},
+			from:  "Am", to: "Gm",
+			want: []string{"Gb"},
+		},
+		{
+			notes: []string{"Bbb"},
+			from:  "C", to: "Db",
+			want: []string{"Bb"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.to, func(t *testing.T) {
+			actual, err := TransposeNotesToKey(test.notes, test.from, test.to)
+			if err != nil {
+				t.Fatalf(`TransposeNotesToKey(%v, "%v", "%v") got error: %v`, test.notes, test.from, test.to, err)
+			}
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`TransposeNotesToKey(%v, "%v", "%v"), actual:"%v", want:"%v"`, test.notes, test.from, test.to, actual, test.want)
+			}
+		})
+	}
+
+	if _, err := TransposeNotesToKey([]string{"C"}, "C4", "D"); err == nil || err.Error() != ErrorNotFoundNote("C4").Error() {
+		t.Errorf(`TransposeNotesToKey() wants Error(%v). but got "%v"`, ErrorNotFoundNote("C4"), err)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name       string
+		preferFlat bool
+		want       string
+	}{
+		{name: "Bbb4", preferFlat: false, want: "A4"},
+		{name: "F##", preferFlat: false, want: "G"},
+		{name: "Ebb", preferFlat: true, want: "D"},
+		{name: "C##3", preferFlat: true, want: "D3"},
+		{name: "Dbb", preferFlat: false, want: "C"},
+		{name: "Abb", preferFlat: true, want: "G"},
+		{name: "E##", preferFlat: true, want: "Gb"},
+		{name: "Cb", preferFlat: false, want: "Cb"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n, _ := Parse(test.name)
+			if actual := n.Simplify(test.preferFlat).String(); actual != test.want {
+				t.Errorf(`Simplify(%v) of "%v", actual:"%v", want:"%v"`, test.preferFlat, test.name, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		tonic   string
+		isMinor bool
+	}{
+		{key: "C", tonic: "C", isMinor: false},
+		{key: "Ebm", tonic: "Eb", isMinor: true},
+		{key: "F#", tonic: "F#", isMinor: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			tonic, isMinor, err := ParseKey(test.key)
+			if err != nil || tonic.String() != test.tonic || isMinor != test.isMinor {
+				t.Errorf(`ParseKey("%v"), actual:"%v", %v, want:"%v", %v. %v`, test.key, tonic, isMinor, test.tonic, test.isMinor, err)
+			}
+		})
+	}
+
+	if _, _, err := ParseKey("C4m"); err == nil || err.Error() != ErrorNotFoundNote("C4m").Error() {
+		t.Errorf(`ParseKey("C4m") wants Error(%v). but got "%v"`, ErrorNotFoundNote("C4m"), err)
+	}
+}
@@ -0,0 +1,155 @@
+package note
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A note parsed from a note name `Eb`, or a note name with octave number `Eb4`
+type Note struct {
+	// Natural letter `E`. `H` is treated as `B`.
+	Letter string
+	// Accidental in semitones. `-1` is `b`, `1` is `#`. `-2` and `2` are double accidentals.
+	Accidental int
+	// Octave number -1 to 9. It's available only when `HasOctave` is true.
+	Octave int
+	// Whether the note has an octave number or not
+	HasOctave bool
+}
+
+// As note name i.e. `Eb`, `C4`, `Eb-1` or `F##3`. Double accidentals are available only on `Parse`.
+var noteTypeRegexp = regexp.MustCompile(`^([A-H])(#{1,2}|b{1,2})?(\-1|[0-9])?$`)
+
+// Parse a note name `Eb`, or a note name with octave number `C4` or `Eb-1` to `Note`.
+// Every name which `NoteNumber()` accepts is available, and double accidentals `Bbb` too.
+func Parse(noteName string) (Note, error) {
+	re := noteTypeRegexp.FindStringSubmatch(noteName)
+	if re == nil {
+		return Note{}, ErrorNotFoundNote(noteName)
+	}
+
+	n := Note{Letter: re[1]}
+	if n.Letter == "H" {
+		n.Letter = "B"
+	}
+
+	if strings.HasPrefix(re[2], "#") {
+		n.Accidental = len(re[2])
+	} else {
+		n.Accidental = -len(re[2])
+	}
+
+	if re[3] != "" {
+		n.Octave, _ = strconv.Atoi(re[3])
+		n.HasOctave = true
+		if _, err := n.MIDI(); err != nil {
+			return Note{}, err
+		}
+	}
+
+	return n, nil
+}
+
+// Get a relative note number `3` of the note `Eb4`
+func (n Note) PitchClass() int {
+	return PitchClassOf(letterDegree[LetterIndex(n.Letter)] + n.Accidental)
+}
+
+// Get an absolute note number `63` of the note `Eb4`. The same as `NoteNumber()`, the octave is applied to the pitch class, so `B#4` is `60`.
+func (n Note) MIDI() (int, error) {
+	if !n.HasOctave {
+		return ErrorInt, ErrorNotFoundOctave(n.String())
+	}
+
+	return actualNoteNumber(n.Octave, n.PitchClass())
+}
+
+// Get a note name `Eb4`. `Parse()` gives back the same note from it.
+func (n Note) String() string {
+	s := n.Letter + AccidentalName(n.Accidental)
+
+	if n.HasOctave {
+		s += strconv.Itoa(n.Octave)
+	}
+
+	return s
+}
+
+// Transpose the note by semitones `3`. `C4` -> `D#4`.
+// A flat note is spelled with flats `Eb4` -> `Gb4`, and others are spelled with sharps.
+func (n Note) Transpose(semitones int) (Note, error) {
+	t := fromPitchClass(PitchClassOf(n.PitchClass()+semitones), n.Accidental < 0)
+	t.HasOctave = n.HasOctave
+
+	return n.moveTo(t, semitones)
+}
+
+// Transpose the note by an interval, semitones `3` and degree `3` (minor 3rd). `C4` -> `Eb4`, `E4` -> `G4`
+func (n Note) TransposeByInterval(semitones int, degree int) (Note, error) {
+	if degree < 1 {
+		return Note{}, ErrorCouldNotSpell(n.String(), semitones, degree)
+	}
+
+	letter := (LetterIndex(n.Letter) + degree - 1) % 7
+	diff := PitchClassOf(n.PitchClass() + semitones - letterDegree[letter])
+	if diff > 6 {
+		diff -= 12
+	}
+	if diff > 2 || diff < -2 {
+		return Note{}, ErrorCouldNotSpell(n.String(), semitones, degree)
+	}
+
+	return n.moveTo(Note{Letter: Letters[letter], Accidental: diff, HasOctave: n.HasOctave}, semitones)
+}
+
+// put an octave number on the transposed note `t`, which is `semitones` away from `n`.
+func (n Note) moveTo(t Note, semitones int) (Note, error) {
+	if !n.HasOctave {
+		return t, nil
+	}
+
+	midi, _ := n.MIDI()
+	moved := midi + semitones
+	if moved < MinimumNoteNumber || moved > MaximumNoteNumber {
+		return Note{}, ErrorOutOfRange
+	}
+	t.Octave = (moved-t.PitchClass())/12 - 1
+
+	return t, nil
+}
+
+// Compare 2 notes by pitch. It's -1 if `n` is lower than `o`, 1 if higher, 0 if the same pitch. i.e. `C#4` and `Db4` are 0.
+// Pitch classes are compared if either of them has no octave.
+func (n Note) Compare(o Note) int {
+	a, b := n.PitchClass(), o.PitchClass()
+	if n.HasOctave && o.HasOctave {
+		a, _ = n.MIDI()
+		b, _ = o.MIDI()
+	}
+
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Check 2 notes are the same pitch with different (or the same) spelling. `C#4` and `Db4`
+func (n Note) IsEnharmonic(o Note) bool {
+	return n.Compare(o) == 0
+}
+
+// get a note without octave from a relative note number `3`, in `FlatTones` `Eb` or in `BaseTones` `D#`.
+func fromPitchClass(pitchClass int, preferFlat bool) Note {
+	name := BaseTones[pitchClass]
+	if preferFlat {
+		name = FlatTones[pitchClass]
+	}
+	n, _ := Parse(name)
+
+	return n
+}
@@ -0,0 +1,221 @@
+package note
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       Note
+		pitchClass int
+		str        string
+	}{
+		{
+			name:       "Eb",
+			want:       Note{Letter: "E", Accidental: -1},
+			pitchClass: 3,
+			str:        "Eb",
+		},
+		{
+			name:       "C4",
+			want:       Note{Letter: "C", Octave: 4, HasOctave: true},
+			pitchClass: 0,
+			str:        "C4",
+		},
+		{
+			name:       "Eb-1",
+			want:       Note{Letter: "E", Accidental: -1, Octave: -1, HasOctave: true},
+			pitchClass: 3,
+			str:        "Eb-1",
+		},
+		{
+			name:       "F##3",
+			want:       Note{Letter: "F", Accidental: 2, Octave: 3, HasOctave: true},
+			pitchClass: 7,
+			str:        "F##3",
+		},
+		{
+			name:       "Bbb",
+			want:       Note{Letter: "B", Accidental: -2},
+			pitchClass: 9,
+			str:        "Bbb",
+		},
+		{
+			name:       "H",
+			want:       Note{Letter: "B"},
+			pitchClass: 11,
+			str:        "B",
+		},
+		{
+			name:       "Cb",
+			want:       Note{Letter: "C", Accidental: -1},
+			pitchClass: 11,
+			str:        "Cb",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Parse(test.name)
+			if err != nil {
+				t.Fatalf(`Parse("%v") got error: %v`, test.name, err)
+			}
+			if actual != test.want {
+				t.Errorf(`Parse("%v"), actual:"%+v", want:"%+v"`, test.name, actual, test.want)
+			}
+			if actual.PitchClass() != test.pitchClass {
+				t.Errorf(`PitchClass() of "%v", actual:"%v", want:"%v"`, test.name, actual.PitchClass(), test.pitchClass)
+			}
+			if actual.String() != test.str {
+				t.Errorf(`String() of "%v", actual:"%v", want:"%v"`, test.name, actual.String(), test.str)
+			}
+			if again, _ := Parse(actual.String()); again != actual {
+				t.Errorf(`Parse("%v") doesn't round-trip. "%+v"`, actual.String(), again)
+			}
+		})
+	}
+}
+
+func TestParseAgreesWithNoteNumber(t *testing.T) {
+	for _, name := range []string{"C", "Db", "B#", "Fb", "Hb", "C-1", "C#0", "B-1", "C4", "Eb4", "G9"} {
+		t.Run(name, func(t *testing.T) {
+			n, err := Parse(name)
+			if err != nil {
+				t.Fatalf(`Parse("%v") got error: %v`, name, err)
+			}
+			want, _ := NoteNumber(name)
+			actual := n.PitchClass()
+			if n.HasOctave {
+				actual, _ = n.MIDI()
+			}
+			if actual != want {
+				t.Errorf(`Parse("%v") is %v, but NoteNumber() is %v`, name, actual, want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name string
+		want error
+	}{
+		{name: "C10", want: ErrorNotFoundNote("C10")},
+		{name: "C-2", want: ErrorNotFoundNote("C-2")},
+		{name: "I9", want: ErrorNotFoundNote("I9")},
+		{name: "C+", want: ErrorNotFoundNote("C+")},
+		{name: "Cbbb", want: ErrorNotFoundNote("Cbbb")},
+		{name: "G#9", want: ErrorOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.name)
+			if err == nil || err.Error() != test.want.Error() {
+				t.Errorf(`Parse("%v"), actual err:"%v", want:"%v"`, test.name, err, test.want)
+			}
+		})
+	}
+}
+
+func TestNoteMIDI(t *testing.T) {
+	n, _ := Parse("Eb4")
+	if actual, _ := n.MIDI(); actual != 63 {
+		t.Errorf(`MIDI() of "Eb4", actual:"%v", want:"63"`, actual)
+	}
+
+	n, _ = Parse("Eb")
+	if actual, err := n.MIDI(); actual != ErrorInt || err.Error() != ErrorNotFoundOctave("Eb").Error() {
+		t.Errorf(`MIDI() of "Eb" wants Error(%v). but got "%v", "%v"`, ErrorNotFoundOctave("Eb"), actual, err)
+	}
+}
+
+func TestNoteTranspose(t *testing.T) {
+	tests := []struct {
+		name      string
+		semitones int
+		want      string
+	}{
+		{name: "C4", semitones: 3, want: "D#4"},
+		{name: "Eb4", semitones: 3, want: "Gb4"},
+		{name: "B3", semitones: 1, want: "C4"},
+		{name: "C4", semitones: -1, want: "B3"},
+		{name: "C4", semitones: 24, want: "C6"},
+		{name: "A", semitones: 5, want: "D"},
+		{name: "C-1", semitones: 127, want: "G9"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n, _ := Parse(test.name)
+			actual, err := n.Transpose(test.semitones)
+			if err != nil {
+				t.Fatalf(`Transpose(%v) of "%v" got error: %v`, test.semitones, test.name, err)
+			}
+			if actual.String() != test.want {
+				t.Errorf(`Transpose(%v) of "%v", actual:"%v", want:"%v"`, test.semitones, test.name, actual, test.want)
+			}
+		})
+	}
+
+	n, _ := Parse("G9")
+	if _, err := n.Transpose(1); err != ErrorOutOfRange {
+		t.Errorf(`Transpose(1) of "G9" wants Error(%v). but got "%v"`, ErrorOutOfRange, err)
+	}
+}
+
+func TestNoteTransposeByInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		semitones int
+		degree    int
+		want      string
+	}{
+		{name: "C4", semitones: 3, degree: 3, want: "Eb4"},
+		{name: "C4", semitones: 3, degree: 2, want: "D#4"},
+		{name: "E4", semitones: 3, degree: 3, want: "G4"},
+		{name: "B3", semitones: 1, degree: 2, want: "C4"},
+		{name: "A3", semitones: 3, degree: 3, want: "C4"},
+		{name: "C4", semitones: 14, degree: 9, want: "D5"},
+		{name: "Bb", semitones: 4, degree: 3, want: "D"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			n, _ := Parse(test.name)
+			actual, err := n.TransposeByInterval(test.semitones, test.degree)
+			if err != nil {
+				t.Fatalf(`TransposeByInterval(%v, %v) of "%v" got error: %v`, test.semitones, test.degree, test.name, err)
+			}
+			if actual.String() != test.want {
+				t.Errorf(`TransposeByInterval(%v, %v) of "%v", actual:"%v", want:"%v"`, test.semitones, test.degree, test.name, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestNoteCompare(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "C4", b: "D4", want: -1},
+		{a: "C5", b: "B4", want: 1},
+		{a: "C#4", b: "Db4", want: 0},
+		{a: "C5", b: "C", want: 0},
+		{a: "E", b: "Fb", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+"-"+test.b, func(t *testing.T) {
+			a, _ := Parse(test.a)
+			b, _ := Parse(test.b)
+			if actual := a.Compare(b); actual != test.want {
+				t.Errorf(`Compare("%v", "%v"), actual:"%v", want:"%v"`, test.a, test.b, actual, test.want)
+			}
+			if a.IsEnharmonic(b) != (test.want == 0) {
+				t.Errorf(`IsEnharmonic("%v", "%v") is wrong`, test.a, test.b)
+			}
+		})
+	}
+}
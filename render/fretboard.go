@@ -0,0 +1,236 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/fretboard"
+)
+
+// Options of a fretboard diagram
+type FretboardOptions struct {
+	// Number of frets to draw. `4` by default, and more if the fingering needs.
+	Frets int
+	// Color of pressed frets. `DefaultColor` by default.
+	Color string
+	// Colors of note numbers over `Color` i.e. for the root `{48: "#e76f51"}`
+	Colors map[int]string
+	// Label under sounding strings
+	Label Label
+	// Spellings of note numbers for labels `{51: "Eb3"}`. Sharps by default.
+	Names map[int]string
+}
+
+// DefaultFrets is the number of frets to draw by default
+const DefaultFrets = 4
+
+// sizes of a fretboard diagram in SVG
+const (
+	stringSpacing = 20
+	fretSpacing   = 24
+	diagramLeft   = 30
+	diagramTop    = 24
+	dotRadius     = 7
+)
+
+// Draw a fingering `x32010` on a fretboard diagram as ASCII art, with strings in order from the left.
+// `x` is a muted string and `o` is an open string above the nut. A pressed fret is `O`, and a barre is `O-O-O`.
+// The diagram starts from the lowest pressed fret with its number `5fr`, if the fingering doesn't fit from the nut.
+func FretboardASCII(f fretboard.Fingering, opts FretboardOptions) (string, error) {
+	d, err := newDiagram(f, opts)
+	if err != nil {
+		return "", err
+	}
+
+	spacing := 2
+	if opts.Label != NoLabel {
+		spacing = 4
+	}
+	width := (len(f.Frets)-1)*spacing + 1
+
+	var lines []string
+	header := []rune(strings.Repeat(" ", width))
+	for i, fret := range f.Frets {
+		switch fret {
+		case -1:
+			header[i*spacing] = 'x'
+		case 0:
+			header[i*spacing] = 'o'
+		}
+	}
+	lines = append(lines, strings.TrimRight(string(header), " "))
+	if d.start == 1 {
+		lines = append(lines, strings.Repeat("=", width))
+	}
+
+	for fret := d.start; fret < d.start+d.frets; fret++ {
+		row := []rune(strings.Repeat(" ", width))
+		for i := range f.Frets {
+			row[i*spacing] = '|'
+		}
+		if fret == f.Barre {
+			for col := d.barreFirst * spacing; col < d.barreLast*spacing; col++ {
+				row[col] = '-'
+			}
+		}
+		for i, fr := range f.Frets {
+			if fr == fret {
+				row[i*spacing] = 'O'
+			}
+		}
+		line := string(row)
+		if fret == d.start && d.start > 1 {
+			line += fmt.Sprintf(" %dfr", d.start)
+		}
+		lines = append(lines, line)
+	}
+
+	if opts.Label != NoLabel {
+		var row []rune
+		last := -2
+		for i, n := range d.notes {
+			if n >= 0 {
+				row, last = putText(row, i*spacing, labelOf(n, opts.Label, opts.Names), last)
+			}
+		}
+		lines = append(lines, strings.TrimRight(string(row), " "))
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// Draw a fingering on a fretboard diagram as SVG. See `FretboardASCII()`.
+func FretboardSVG(f fretboard.Fingering, opts FretboardOptions) (string, error) {
+	d, err := newDiagram(f, opts)
+	if err != nil {
+		return "", err
+	}
+
+	right := diagramLeft + (len(f.Frets)-1)*stringSpacing
+	bottom := diagramTop + d.frets*fretSpacing
+	width := right + stringSpacing
+	height := bottom + 8
+	if opts.Label != NoLabel {
+		height += labelHeight
+	}
+
+	var b strings.Builder
+	svgHeader(&b, width, height)
+	for i := range f.Frets {
+		x := diagramLeft + i*stringSpacing
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`+"\n", x, diagramTop, x, bottom, lineColor)
+	}
+	for fret := 0; fret <= d.frets; fret++ {
+		y := diagramTop + fret*fretSpacing
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`+"\n", diagramLeft, y, right, y, lineColor)
+	}
+	if d.start == 1 {
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="4" fill="%s"/>`+"\n", diagramLeft, diagramTop-4, right-diagramLeft, lineColor)
+	} else {
+		svgText(&b, diagramLeft-dotRadius-3, diagramTop+fretSpacing/2+4, "end", lineColor, fmt.Sprintf("%dfr", d.start))
+	}
+
+	for i, fret := range f.Frets {
+		x := diagramLeft + i*stringSpacing
+		switch fret {
+		case -1:
+			svgText(&b, x, diagramTop-8, "middle", lineColor, "x")
+		case 0:
+			fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="4" fill="none" stroke="%s"/>`+"\n", x, diagramTop-12, lineColor)
+		}
+	}
+	if f.Barre > 0 {
+		y := diagramTop + (f.Barre-d.start)*fretSpacing + fretSpacing/2
+		x := diagramLeft + d.barreFirst*stringSpacing - dotRadius
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="%s"/>`+"\n",
+			x, y-dotRadius, (d.barreLast-d.barreFirst)*stringSpacing+dotRadius*2, dotRadius*2, dotRadius, colorOf(-1, opts.Color, nil))
+	}
+	for i, fret := range f.Frets {
+		if fret <= 0 || fret == f.Barre && i >= d.barreFirst && i <= d.barreLast {
+			continue
+		}
+		x := diagramLeft + i*stringSpacing
+		y := diagramTop + (fret-d.start)*fretSpacing + fretSpacing/2
+		fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="%d" fill="%s"/>`+"\n", x, y, dotRadius, colorOf(d.notes[i], opts.Color, opts.Colors))
+	}
+	if opts.Label != NoLabel {
+		for i, n := range d.notes {
+			if n >= 0 {
+				svgText(&b, diagramLeft+i*stringSpacing, bottom+18, "middle", lineColor, labelOf(n, opts.Label, opts.Names))
+			}
+		}
+	}
+	b.WriteString("</svg>\n")
+
+	return b.String(), nil
+}
+
+type diagram struct {
+	// the first fret to draw
+	start int
+	// number of frets to draw
+	frets int
+	// strings under the barre
+	barreFirst int
+	barreLast  int
+	// note number of each string, or -1 for a muted string
+	notes []int
+}
+
+func newDiagram(f fretboard.Fingering, opts FretboardOptions) (diagram, error) {
+	if err := validateLabel(opts.Label); err != nil {
+		return diagram{}, err
+	}
+	if len(f.Frets) == 0 {
+		return diagram{}, ErrorInvalidFingering(f.Frets)
+	}
+
+	d := diagram{start: 1, frets: opts.Frets, barreFirst: -1, barreLast: -1}
+	if d.frets <= 0 {
+		d.frets = DefaultFrets
+	}
+
+	low, high, sounding := 0, 0, 0
+	for i, fret := range f.Frets {
+		if fret < -1 {
+			return diagram{}, ErrorInvalidFingering(f.Frets)
+		}
+		if fret >= 0 {
+			sounding++
+		}
+		if fret > 0 && (low == 0 || fret < low) {
+			low = fret
+		}
+		if fret > high {
+			high = fret
+		}
+		if f.Barre > 0 && fret == f.Barre {
+			if d.barreFirst < 0 {
+				d.barreFirst = i
+			}
+			d.barreLast = i
+		}
+	}
+	if sounding != len(f.Notes) || f.Barre > 0 && (d.barreFirst < 0 || f.Barre != low) {
+		return diagram{}, ErrorInvalidFingering(f.Frets)
+	}
+
+	if high > d.frets {
+		d.start = low
+	}
+	if high-d.start+1 > d.frets {
+		d.frets = high - d.start + 1
+	}
+
+	next := 0
+	for _, fret := range f.Frets {
+		if fret < 0 {
+			d.notes = append(d.notes, -1)
+			continue
+		}
+		d.notes = append(d.notes, f.Notes[next])
+		next++
+	}
+
+	return d, nil
+}
@@ -0,0 +1,65 @@
+package render
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/fretboard"
+)
+
+func TestFretboard(t *testing.T) {
+	c, _ := fretboard.Find("C", fretboard.Options{})
+	f, _ := fretboard.Find("F", fretboard.Options{NoOpen: true})
+	bb, _ := fretboard.Find("Bbm7", fretboard.Options{NoOpen: true, MaxFret: 8})
+	uke, _ := fretboard.Find("C", fretboard.Options{Tuning: fretboard.Ukulele})
+
+	tests := []struct {
+		name      string
+		fingering fretboard.Fingering
+		opts      FretboardOptions
+	}{
+		{name: "fretboard_c", fingering: c[0], opts: FretboardOptions{}},
+		{name: "fretboard_f_barre", fingering: f[0], opts: FretboardOptions{Label: NameLabel, Colors: map[int]string{41: "#e76f51", 53: "#e76f51", 65: "#e76f51"}}},
+		{name: "fretboard_bbm7_position", fingering: bb[0], opts: FretboardOptions{Label: OctaveLabel, Names: map[int]string{46: "Bb2", 53: "F3", 56: "Ab3", 61: "Db4", 65: "F4", 70: "Bb4"}}},
+		{name: "fretboard_ukulele_c", fingering: uke[0], opts: FretboardOptions{Frets: 5, Color: "#2a9d8f"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ascii, err := FretboardASCII(test.fingering, test.opts)
+			if err != nil {
+				t.Fatalf(`FretboardASCII(%v) got error: %v`, test.fingering, err)
+			}
+			assertGolden(t, test.name+".txt", ascii)
+
+			svg, err := FretboardSVG(test.fingering, test.opts)
+			if err != nil {
+				t.Fatalf(`FretboardSVG(%v) got error: %v`, test.fingering, err)
+			}
+			assertGolden(t, test.name+".svg", svg)
+		})
+	}
+}
+
+func TestFretboardError(t *testing.T) {
+	tests := []struct {
+		fingering fretboard.Fingering
+		opts      FretboardOptions
+		want      error
+	}{
+		{fingering: fretboard.Fingering{}, want: ErrInvalidFingering},
+		{fingering: fretboard.Fingering{Frets: []int{-2, 0}, Notes: []int{45}}, want: ErrInvalidFingering},
+		{fingering: fretboard.Fingering{Frets: []int{0, 0}, Notes: []int{45}}, want: ErrInvalidFingering},
+		{fingering: fretboard.Fingering{Frets: []int{3, 5}, Notes: []int{43, 50}, Barre: 2}, want: ErrInvalidFingering},
+		{fingering: fretboard.Fingering{Frets: []int{0}, Notes: []int{40}}, opts: FretboardOptions{Label: "degree"}, want: ErrInvalidLabel},
+	}
+
+	for _, test := range tests {
+		if _, err := FretboardASCII(test.fingering, test.opts); !errors.Is(err, test.want) {
+			t.Errorf(`FretboardASCII(%+v), actual:"%v", want:"%v"`, test.fingering, err, test.want)
+		}
+		if _, err := FretboardSVG(test.fingering, test.opts); !errors.Is(err, test.want) {
+			t.Errorf(`FretboardSVG(%+v), actual:"%v", want:"%v"`, test.fingering, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Options of a piano keyboard
+type KeyboardOptions struct {
+	// Range of keys in note number, both ends are included. A black key at an end is widened to the white key next to it.
+	// Octaves from `C` around the notes by default, when both are 0.
+	Low  int
+	High int
+	// Color of highlighted keys. `DefaultColor` by default.
+	Color string
+	// Colors of note numbers over `Color` i.e. for the root `{60: "#e76f51"}`
+	Colors map[int]string
+	// Label on highlighted keys
+	Label Label
+	// Spellings of note numbers for labels `{63: "Eb4"}`. Black keys are spelled with sharps by default. See `NotesOf()`.
+	Names map[int]string
+}
+
+// sizes of keys in SVG
+const (
+	whiteWidth  = 24
+	whiteHeight = 120
+	blackWidth  = 14
+	blackHeight = 76
+	labelHeight = 16
+)
+
+// Draw note numbers `{60, 64, 67}` on a piano keyboard as ASCII art.
+// i.e. a result of `scale.GetScaleFromRoot()`, or of `NotesOf()` for `chord.GetChordWithOctave()`.
+// Highlighted keys are marked with `*`, and labels are put under the keyboard. Notes out of the range are not drawn.
+func KeyboardASCII(noteNumbers []int, opts KeyboardOptions) (string, error) {
+	whites, highlighted, err := keyboard(noteNumbers, opts)
+	if err != nil {
+		return "", err
+	}
+
+	width := len(whites)*4 + 1
+	rows := make([][]rune, 4)
+	for r := range rows {
+		rows[r] = []rune(strings.Repeat(" ", width))
+		for i := 0; i <= len(whites); i++ {
+			rows[r][i*4] = '|'
+		}
+	}
+	for i := range whites {
+		copy(rows[3][i*4+1:], []rune("___"))
+		if highlighted[whites[i]] {
+			rows[2][i*4+2] = '*'
+		}
+	}
+
+	type label struct {
+		col  int
+		text string
+	}
+	var labels []label
+	for i, w := range whites {
+		if highlighted[w] {
+			labels = append(labels, label{col: i*4 + 2, text: labelOf(w, opts.Label, opts.Names)})
+		}
+		if i == len(whites)-1 || whites[i+1] == w+1 {
+			continue
+		}
+		// a black key between white keys
+		copy(rows[0][i*4+3:], []rune("###"))
+		copy(rows[1][i*4+3:], []rune("###"))
+		if highlighted[w+1] {
+			rows[1][i*4+4] = '*'
+			labels = append(labels, label{col: i*4 + 4, text: labelOf(w+1, opts.Label, opts.Names)})
+		}
+	}
+
+	var lines []string
+	for _, row := range rows {
+		lines = append(lines, string(row))
+	}
+
+	if opts.Label != NoLabel && len(labels) > 0 {
+		sort.SliceStable(labels, func(i, j int) bool { return labels[i].col < labels[j].col })
+		var row []rune
+		last := -2
+		for _, l := range labels {
+			row, last = putText(row, l.col-(len(l.text)-1)/2, l.text, last)
+		}
+		lines = append(lines, string(row))
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// Draw note numbers `{60, 64, 67}` on a piano keyboard as SVG. See `KeyboardASCII()`.
+func KeyboardSVG(noteNumbers []int, opts KeyboardOptions) (string, error) {
+	whites, highlighted, err := keyboard(noteNumbers, opts)
+	if err != nil {
+		return "", err
+	}
+
+	width := len(whites)*whiteWidth + 1
+	height := whiteHeight + 1
+	if opts.Label != NoLabel {
+		height += labelHeight
+	}
+
+	var b strings.Builder
+	svgHeader(&b, width, height)
+	for i, w := range whites {
+		fill := whiteColor
+		if highlighted[w] {
+			fill = colorOf(w, opts.Color, opts.Colors)
+		}
+		fmt.Fprintf(&b, `<rect x="%d" y="0" width="%d" height="%d" fill="%s" stroke="%s"/>`+"\n", i*whiteWidth, whiteWidth, whiteHeight, fill, lineColor)
+	}
+	for i, w := range whites {
+		if i == len(whites)-1 || whites[i+1] == w+1 {
+			continue
+		}
+		fill := blackColor
+		if highlighted[w+1] {
+			fill = colorOf(w+1, opts.Color, opts.Colors)
+		}
+		fmt.Fprintf(&b, `<rect x="%d" y="0" width="%d" height="%d" fill="%s" stroke="%s"/>`+"\n", (i+1)*whiteWidth-blackWidth/2, blackWidth, blackHeight, fill, lineColor)
+	}
+	if opts.Label != NoLabel {
+		for i, w := range whites {
+			if highlighted[w] {
+				svgText(&b, i*whiteWidth+whiteWidth/2, whiteHeight+12, "middle", lineColor, labelOf(w, opts.Label, opts.Names))
+			}
+			if i < len(whites)-1 && whites[i+1] != w+1 && highlighted[w+1] {
+				svgText(&b, (i+1)*whiteWidth, whiteHeight+12, "middle", lineColor, labelOf(w+1, opts.Label, opts.Names))
+			}
+		}
+	}
+	b.WriteString("</svg>\n")
+
+	return b.String(), nil
+}
+
+// get white keys in the range and highlighted note numbers
+func keyboard(noteNumbers []int, opts KeyboardOptions) ([]int, map[int]bool, error) {
+	if err := validateLabel(opts.Label); err != nil {
+		return nil, nil, err
+	}
+
+	highlighted := map[int]bool{}
+	for _, n := range noteNumbers {
+		if n < note.MinimumNoteNumber || n > note.MaximumNoteNumber {
+			return nil, nil, note.ErrorOutOfRange
+		}
+		highlighted[n] = true
+	}
+
+	low, high := opts.Low, opts.High
+	if low == 0 && high == 0 {
+		low, high = 60, 71
+		if len(noteNumbers) > 0 {
+			low, high = noteNumbers[0], noteNumbers[0]
+			for _, n := range noteNumbers {
+				if n < low {
+					low = n
+				}
+				if n > high {
+					high = n
+				}
+			}
+			low, high = low-low%12, high-high%12+11
+			if high > note.MaximumNoteNumber {
+				high = note.MaximumNoteNumber
+			}
+		}
+	}
+	if low < note.MinimumNoteNumber || high > note.MaximumNoteNumber || low > high {
+		return nil, nil, ErrorInvalidRange(opts.Low, opts.High)
+	}
+	if isBlack(low) {
+		low--
+	}
+	if isBlack(high) {
+		high++
+	}
+
+	var whites []int
+	for n := low; n <= high; n++ {
+		if !isBlack(n) {
+			whites = append(whites, n)
+		}
+	}
+
+	return whites, highlighted, nil
+}
+
+func isBlack(noteNumber int) bool {
+	switch noteNumber % 12 {
+	case 1, 3, 6, 8, 10:
+		return true
+	}
+
+	return false
+}
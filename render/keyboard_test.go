@@ -0,0 +1,76 @@
+package render
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+func TestKeyboard(t *testing.T) {
+	cm7, _ := chord.GetChordWithOctave("CM7", 4)
+	// spelled with flats, while chord.GetChordWithOctave() gives sharps
+	ebm := []string{"Eb4", "Gb4", "Bb4"}
+	dMajor, _ := scale.GetScaleFromRoot("ionian", "D4")
+
+	tests := []struct {
+		name      string
+		noteNames []string
+		notes     []int
+		opts      KeyboardOptions
+	}{
+		{name: "keyboard_cm7", noteNames: cm7, opts: KeyboardOptions{}},
+		{name: "keyboard_ebm_labels", noteNames: ebm, opts: KeyboardOptions{Label: NameLabel}},
+		{name: "keyboard_d_ionian", notes: dMajor, opts: KeyboardOptions{Low: 61, High: 75, Label: OctaveLabel, Color: "#2a9d8f", Colors: map[int]string{62: "#e76f51"}}},
+		{name: "keyboard_empty", opts: KeyboardOptions{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notes, opts := test.notes, test.opts
+			if test.noteNames != nil {
+				var err error
+				notes, opts.Names, err = NotesOf(test.noteNames)
+				if err != nil {
+					t.Fatalf(`NotesOf(%v) got error: %v`, test.noteNames, err)
+				}
+			}
+
+			ascii, err := KeyboardASCII(notes, opts)
+			if err != nil {
+				t.Fatalf(`KeyboardASCII(%v) got error: %v`, notes, err)
+			}
+			assertGolden(t, test.name+".txt", ascii)
+
+			svg, err := KeyboardSVG(notes, opts)
+			if err != nil {
+				t.Fatalf(`KeyboardSVG(%v) got error: %v`, notes, err)
+			}
+			assertGolden(t, test.name+".svg", svg)
+		})
+	}
+}
+
+func TestKeyboardError(t *testing.T) {
+	tests := []struct {
+		notes []int
+		opts  KeyboardOptions
+		want  error
+	}{
+		{notes: []int{128}, opts: KeyboardOptions{}, want: note.ErrOutOfRange},
+		{notes: []int{60}, opts: KeyboardOptions{Low: 72, High: 60}, want: ErrInvalidRange},
+		{notes: []int{60}, opts: KeyboardOptions{Low: -1, High: 60}, want: ErrInvalidRange},
+		{notes: []int{60}, opts: KeyboardOptions{Label: "degree"}, want: ErrInvalidLabel},
+	}
+
+	for _, test := range tests {
+		if _, err := KeyboardASCII(test.notes, test.opts); !errors.Is(err, test.want) {
+			t.Errorf(`KeyboardASCII(%v, %+v), actual:"%v", want:"%v"`, test.notes, test.opts, err, test.want)
+		}
+		if _, err := KeyboardSVG(test.notes, test.opts); !errors.Is(err, test.want) {
+			t.Errorf(`KeyboardSVG(%v, %+v), actual:"%v", want:"%v"`, test.notes, test.opts, err, test.want)
+		}
+	}
+}
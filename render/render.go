@@ -0,0 +1,126 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Label on highlighted notes
+type Label string
+
+const (
+	// No label
+	NoLabel Label = ""
+	// Note name `Eb`
+	NameLabel Label = "name"
+	// Note name with octave number `Eb4`
+	OctaveLabel Label = "octave"
+)
+
+// Colors by default
+const (
+	DefaultColor = "#4a90d9"
+	lineColor    = "#000000"
+	whiteColor   = "#ffffff"
+	blackColor   = "#222222"
+)
+
+// Sentinel errors
+var (
+	ErrInvalidRange     = errors.New("Invalid range of keys")
+	ErrInvalidFingering = errors.New("Invalid fingering")
+	ErrInvalidLabel     = errors.New("Invalid label")
+)
+
+var (
+	ErrorInvalidRange     = func(low int, high int) error { return fmt.Errorf("%w. %d-%d", ErrInvalidRange, low, high) }
+	ErrorInvalidFingering = func(frets []int) error { return fmt.Errorf("%w. %v", ErrInvalidFingering, frets) }
+	ErrorInvalidLabel     = func(label Label) error { return fmt.Errorf("%w. `%s`", ErrInvalidLabel, label) }
+)
+
+// Get note numbers and spellings from note names with octave `{"Eb4", "G4", "Bb4"}` i.e. a result of `chord.GetChordWithOctave()`.
+// The spellings are for `Names` of options.
+func NotesOf(noteNames []string) ([]int, map[int]string, error) {
+	var notes []int
+	names := map[int]string{}
+	for _, noteName := range noteNames {
+		n, err := note.Parse(noteName)
+		if err != nil {
+			return nil, nil, err
+		}
+		number, err := n.MIDI()
+		if err != nil {
+			return nil, nil, err
+		}
+		notes = append(notes, number)
+		names[number] = n.String()
+	}
+
+	return notes, names, nil
+}
+
+// text of a label of a note number. A spelling in names wins over the sharp name.
+func labelOf(noteNumber int, label Label, names map[int]string) string {
+	name, ok := names[noteNumber]
+	if !ok {
+		name, _ = note.NoteName(noteNumber)
+	}
+	if label == OctaveLabel {
+		return name
+	}
+
+	return strings.TrimRight(name, "-0123456789")
+}
+
+// color of a note number. A color in colors wins over the default one.
+func colorOf(noteNumber int, color string, colors map[int]string) string {
+	if c, ok := colors[noteNumber]; ok {
+		return c
+	}
+	if color == "" {
+		return DefaultColor
+	}
+
+	return color
+}
+
+func validateLabel(label Label) error {
+	if label != NoLabel && label != NameLabel && label != OctaveLabel {
+		return ErrorInvalidLabel(label)
+	}
+
+	return nil
+}
+
+// put text in a row of runes at the column, or after the last text if it overlaps. It's from the first column at least.
+func putText(row []rune, col int, text string, last int) ([]rune, int) {
+	if col <= last {
+		col = last + 1
+	}
+	if col < 0 {
+		col = 0
+	}
+	runes := []rune(text)
+	for len(row) < col+len(runes) {
+		row = append(row, ' ')
+	}
+	copy(row[col:], runes)
+
+	return row, col + len(runes)
+}
+
+// escape text for SVG
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
+
+func svgHeader(b *strings.Builder, width int, height int) {
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+}
+
+func svgText(b *strings.Builder, x int, y int, anchor string, fill string, text string) {
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-family="sans-serif" font-size="10" text-anchor="%s" fill="%s">%s</text>`+"\n", x, y, anchor, fill, escape(text))
+}
@@ -0,0 +1,103 @@
+package render
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// compare with a golden file in testdata. `go test ./render -update` writes it.
+func assertGolden(t *testing.T, name string, actual string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Fatalf(`could not write %s: %v`, path, err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf(`could not read %s: %v`, path, err)
+	}
+	if actual != string(want) {
+		t.Errorf("%s, actual:\n%s\nwant:\n%s", name, actual, want)
+	}
+}
+
+func TestNotesOf(t *testing.T) {
+	notes, names, err := NotesOf([]string{"Eb4", "G4", "Bb4"})
+	if err != nil {
+		t.Fatalf(`NotesOf got error: %v`, err)
+	}
+	if want := []int{63, 67, 70}; !reflect.DeepEqual(notes, want) {
+		t.Errorf(`NotesOf, actual:%v, want:%v`, notes, want)
+	}
+	if want := map[int]string{63: "Eb4", 67: "G4", 70: "Bb4"}; !reflect.DeepEqual(names, want) {
+		t.Errorf(`NotesOf, actual:%v, want:%v`, names, want)
+	}
+
+	if _, _, err := NotesOf([]string{"Eb"}); err == nil {
+		t.Errorf(`NotesOf("Eb") should be an error without octave`)
+	}
+}
+
+func TestLabelOf(t *testing.T) {
+	tests := []struct {
+		noteNumber int
+		label      Label
+		names      map[int]string
+		want       string
+	}{
+		{noteNumber: 61, label: NameLabel, want: "C#"},
+		{noteNumber: 61, label: OctaveLabel, want: "C#4"},
+		{noteNumber: 61, label: NameLabel, names: map[int]string{61: "Db4"}, want: "Db"},
+		{noteNumber: 11, label: NameLabel, want: "B"},
+		{noteNumber: 11, label: OctaveLabel, want: "B-1"},
+	}
+
+	for _, test := range tests {
+		if actual := labelOf(test.noteNumber, test.label, test.names); actual != test.want {
+			t.Errorf(`labelOf(%d, %q), actual:%q, want:%q`, test.noteNumber, test.label, actual, test.want)
+		}
+	}
+}
+
+func TestPutText(t *testing.T) {
+	tests := []struct {
+		col      int
+		text     string
+		last     int
+		want     string
+		wantLast int
+	}{
+		{col: 2, text: "C", last: -2, want: "  C", wantLast: 3},
+		{col: -3, text: "C4-root", last: -2, want: "C4-root", wantLast: 7},
+		{col: 1, text: "E", last: 3, want: "    E", wantLast: 5},
+		{col: 0, text: "C♯", last: -2, want: "C♯", wantLast: 2},
+	}
+
+	for _, test := range tests {
+		row, last := putText(nil, test.col, test.text, test.last)
+		if string(row) != test.want || last != test.wantLast {
+			t.Errorf(`putText(%d, %q, %d), actual:%q %d, want:%q %d`, test.col, test.text, test.last, string(row), last, test.want, test.wantLast)
+		}
+	}
+}
+
+func TestKeyboardLongLabel(t *testing.T) {
+	ascii, err := KeyboardASCII([]int{60, 63, 67}, KeyboardOptions{Label: NameLabel, Names: map[int]string{60: "C4-root"}})
+	if err != nil {
+		t.Fatalf(`KeyboardASCII got error: %v`, err)
+	}
+	lines := strings.Split(strings.TrimSuffix(ascii, "\n"), "\n")
+	if label := lines[len(lines)-1]; !strings.HasPrefix(label, labelOf(60, NameLabel, map[int]string{60: "C4-root"})) {
+		t.Errorf(`KeyboardASCII, actual label:%q`, label)
+	}
+}
@@ -0,0 +1,337 @@
+package roman
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+// Function of a chord in a key
+type Function string
+
+const (
+	// Made of notes of the key
+	Diatonic Function = "diatonic"
+	// Dominant or leading-tone chord of a diatonic chord `V7/V`
+	Secondary Function = "secondary"
+	// Borrowed from the parallel key or modes `bVII`, `iv`
+	Borrowed Function = "borrowed"
+	// Other chords i.e. the Neapolitan `N6`, augmented sixths `Ger+6`
+	Chromatic Function = "chromatic"
+)
+
+// A reading of a chord in a key
+type Analysis struct {
+	// Roman numeral symbol `V7/V`
+	Symbol   string
+	Function Function
+}
+
+// Sentinel errors. Errors from this package wrap one of them, or an error of `note` or `chord`.
+var ErrInvalid = errors.New("Invalid roman numeral")
+
+var ErrorInvalidNumeral = func(symbol string, pos int) error { return note.NewParseError(symbol, pos, ErrInvalid) }
+
+var numerals = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+
+// scales of the key and of the parallel key by major or minor
+var (
+	keyScales      = map[bool][]string{false: {"ionian"}, true: {"aeolian", "harmonic-minor", "super-ionian"}}
+	borrowedScales = map[bool][]string{false: {"aeolian", "dorian", "phrigian", "mixolydian"}, true: {"ionian"}}
+)
+
+var regexpNumeral = regexp.MustCompile(`^([b#♭♯]?)(VII|VI|IV|V|III|II|I|vii|vi|iv|v|iii|ii|i)([°oø+]?)(.*)$`)
+
+// Get a chord `D7` from a roman numeral symbol `V7/V` in a key `C`. A key ends with `m` for minor `Am`.
+// In a minor key, `vii°` and `viiø` are on the raised 7th. `N6` is the Neapolitan on the 4th in the bass,
+// and `Fr+6`, `Ger+6` are written as the 7th chords on the `b6` which they sound as.
+// `It+6` is invalid, since it has no 5th and no kind of chord is for it.
+func Parse(symbol string, key string) (chord.Chord, error) {
+	tonic, isMinor, err := note.ParseKey(key)
+	if err != nil {
+		return chord.Chord{}, err
+	}
+
+	chordName, err := resolve(symbol, 0, tonic, isMinor)
+	if err != nil {
+		return chord.Chord{}, err
+	}
+
+	return chord.Parse(chordName)
+}
+
+// Get a full chord name `D7` from a roman numeral symbol `V7/V` in a key `C`. See `Parse()`.
+func ChordName(symbol string, key string) (string, error) {
+	c, err := Parse(symbol, key)
+	if err != nil {
+		return "", err
+	}
+
+	return c.String(), nil
+}
+
+// get a chord name from a symbol at `pos` of the whole symbol, relative to a tonic
+func resolve(symbol string, pos int, tonic note.Note, isMinor bool) (string, error) {
+	if i := strings.Index(symbol, "/"); i >= 0 {
+		target, err := resolve(symbol[i+1:], pos+i+1, tonic, isMinor)
+		if err != nil {
+			return "", err
+		}
+		t, _ := chord.Parse(target)
+		if !isMajorOrMinor(t) {
+			return "", ErrorInvalidNumeral(symbol[i+1:], pos+i+1)
+		}
+		targetRoot, _ := note.Parse(t.Root)
+
+		return resolve(symbol[:i], pos, targetRoot, isMinorChord(t))
+	}
+
+	switch symbol {
+	case "N", "N6":
+		root, err := note.DegreeNote(tonic, false, 2, -1)
+		if err != nil {
+			return "", err
+		}
+		if symbol == "N" {
+			return root.String(), nil
+		}
+		third, err := root.TransposeByInterval(4, 3)
+		if err != nil {
+			return "", err
+		}
+		return root.String() + "/" + third.String(), nil
+	case "It+6", "It6":
+		return "", ErrorInvalidNumeral(symbol, pos)
+	case "Ger+6", "Ger6", "Fr+6", "Fr6":
+		root, err := note.DegreeNote(tonic, false, 6, -1)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(symbol, "Fr") {
+			return root.String() + "7b5", nil
+		}
+		return root.String() + "7", nil
+	}
+
+	re := regexpNumeral.FindStringSubmatch(symbol)
+	if re == nil {
+		return "", ErrorInvalidNumeral(symbol, pos)
+	}
+	accidental, numeral, quality, fig := re[1], re[2], re[3], re[4]
+
+	degree := indexOfString(numerals, strings.ToUpper(numeral)) + 1
+	shift := map[string]int{"": 0, "b": -1, "♭": -1, "#": 1, "♯": 1}[accidental]
+	if isMinor && degree == 7 && accidental == "" && (quality == "°" || quality == "o" || quality == "ø") {
+		shift = 1
+	}
+	root, err := note.DegreeNote(tonic, isMinor, degree, shift)
+	if err != nil {
+		return "", err
+	}
+
+	kind, ok := kindOf(numeral == strings.ToUpper(numeral), quality, fig)
+	if !ok {
+		return "", ErrorInvalidNumeral(symbol, pos+len(symbol)-len(fig))
+	}
+	chordName := root.String() + kind
+	if _, err := chord.Parse(chordName); err != nil {
+		return "", ErrorInvalidNumeral(symbol, pos+len(symbol)-len(fig))
+	}
+
+	return chordName, nil
+}
+
+// get a kind of chord from the case of a numeral, a quality `°` and a figure `7`
+func kindOf(isUpper bool, quality string, fig string) (string, bool) {
+	switch fig {
+	case "maj7", "Δ7", "Δ":
+		fig = "M7"
+	case "maj9", "Δ9":
+		fig = "M9"
+	}
+
+	switch quality {
+	case "°", "o":
+		if fig != "" && fig != "7" {
+			return "", false
+		}
+		return "dim" + fig, true
+	case "ø":
+		if fig != "" && fig != "7" {
+			return "", false
+		}
+		return "m7b5", true
+	case "+":
+		return "aug" + fig, true
+	}
+
+	if isUpper {
+		return fig, true
+	}
+
+	return "m" + fig, true
+}
+
+// Get readings of a chord `D7` in a key `C` -> `V7/V`. A key ends with `m` for minor `Am`.
+// A diatonic chord has only a diatonic reading. Others are in order of secondary, borrowed and chromatic.
+// The bass of a slash chord is ignored, except for the Neapolitan `N6`.
+func Analyze(chordName string, key string) ([]Analysis, error) {
+	tonic, isMinor, err := note.ParseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := chord.Parse(chordName)
+	if err != nil {
+		return nil, err
+	}
+
+	if fits(c, tonic, keyScales[isMinor]) {
+		return []Analysis{{Symbol: numeral(c, tonic, isMinor), Function: Diatonic}}, nil
+	}
+
+	var analyses []Analysis
+	if symbol, ok, err := secondary(c, tonic, isMinor); err != nil {
+		return nil, err
+	} else if ok {
+		analyses = append(analyses, Analysis{Symbol: symbol, Function: Secondary})
+	}
+
+	if fits(c, tonic, borrowedScales[isMinor]) {
+		analyses = append(analyses, Analysis{Symbol: numeral(c, tonic, isMinor), Function: Borrowed})
+	}
+
+	for _, symbol := range []string{"N6", "N", "Ger+6", "Fr+6"} {
+		if s, err := ChordName(symbol, key); err == nil && s == c.String() {
+			analyses = append(analyses, Analysis{Symbol: symbol, Function: Chromatic})
+			break
+		}
+	}
+
+	if len(analyses) == 0 {
+		analyses = append(analyses, Analysis{Symbol: numeral(c, tonic, isMinor), Function: Chromatic})
+	}
+
+	return analyses, nil
+}
+
+// get a secondary dominant or leading-tone reading `V7/V` if the chord resolves to a diatonic major or minor triad except the tonic
+func secondary(c chord.Chord, tonic note.Note, isMinor bool) (string, bool, error) {
+	pcs := map[int]bool{}
+	for _, n := range c.Intervals {
+		pcs[n%12] = true
+	}
+
+	var resolution int
+	switch {
+	case pcs[4] && pcs[7] && !pcs[11]:
+		resolution = 5
+	case pcs[3] && pcs[6] && !pcs[7]:
+		resolution = 1
+	default:
+		return "", false, nil
+	}
+
+	diatonic, err := scale.Harmonize(keyScales[isMinor][0], tonic.String(), scale.Triad)
+	if err != nil {
+		return "", false, err
+	}
+	for _, d := range diatonic[1:] {
+		target, err := chord.Parse(d.Symbol)
+		if err != nil || !isMajorOrMinor(target) || target.RootPitchClass != (c.RootPitchClass+resolution)%12 {
+			continue
+		}
+		targetRoot, _ := note.Parse(target.Root)
+		return numeral(c, targetRoot, isMinorChord(target)) + "/" + numeral(target, tonic, isMinor), true, nil
+	}
+
+	return "", false, nil
+}
+
+// get a roman numeral of a chord relative to a tonic. `Bb7` in `C` -> `bVII7`
+func numeral(c chord.Chord, tonic note.Note, isMinor bool) string {
+	root, _ := note.Parse(c.Root)
+	degree, shift := note.DegreeOf(root, tonic, isMinor)
+
+	isUpper, quality, fig := figureOf(c.Kind)
+	if isMinor && degree == 7 && shift == 1 && (quality == "°" || quality == "ø") {
+		shift = 0
+	}
+
+	symbol := numerals[degree-1]
+	if !isUpper {
+		symbol = strings.ToLower(symbol)
+	}
+
+	return note.AccidentalName(shift) + symbol + quality + fig
+}
+
+// get the case of a numeral, a quality and a figure from a kind of chord. `m7` -> lower case, no quality and `7`
+func figureOf(kind string) (bool, string, string) {
+	switch {
+	case kind == "base":
+		return true, "", ""
+	case kind == "dim" || kind == "dim7":
+		return false, "°", kind[3:]
+	case kind == "m7b5":
+		return false, "ø", "7"
+	case strings.HasPrefix(kind, "aug"):
+		return true, "+", kind[3:]
+	case strings.HasPrefix(kind, "m"):
+		return false, "", kind[1:]
+	}
+
+	return true, "", kind
+}
+
+// whether every note of a chord is in any of scales on a tonic
+func fits(c chord.Chord, tonic note.Note, scaleNames []string) bool {
+	for _, scaleName := range scaleNames {
+		sc, _ := scale.GetScale(scaleName)
+		in := true
+		for _, n := range c.Intervals {
+			if indexOf(sc, note.PitchClassOf(c.RootPitchClass+n-tonic.PitchClass())) < 0 {
+				in = false
+				break
+			}
+		}
+		if in {
+			return true
+		}
+	}
+
+	return false
+}
+
+// whether a chord has the major or the minor triad, which can be tonicized
+func isMajorOrMinor(c chord.Chord) bool {
+	return indexOf(c.Intervals, 7) >= 0 && (indexOf(c.Intervals, 3) >= 0) != (indexOf(c.Intervals, 4) >= 0)
+}
+
+func isMinorChord(c chord.Chord) bool {
+	return indexOf(c.Intervals, 3) >= 0 && indexOf(c.Intervals, 4) < 0
+}
+
+func indexOfString(list []string, v string) int {
+	for i, e := range list {
+		if e == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func indexOf(list []int, v int) int {
+	for i, e := range list {
+		if e == v {
+			return i
+		}
+	}
+
+	return -1
+}
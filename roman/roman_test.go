@@ -0,0 +1,141 @@
+package roman
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestChordName(t *testing.T) {
+	tests := []struct {
+		symbol string
+		key    string
+		want   string
+	}{
+		{symbol: "ii7", key: "C", want: "Dm7"},
+		{symbol: "V7", key: "C", want: "G7"},
+		{symbol: "IM7", key: "C", want: "CM7"},
+		{symbol: "Imaj7", key: "C", want: "CM7"},
+		{symbol: "IΔ7", key: "C", want: "CM7"},
+		{symbol: "bVII7", key: "C", want: "Bb7"},
+		{symbol: "♭VI", key: "C", want: "Ab"},
+		{symbol: "iv", key: "C", want: "Fm"},
+		{symbol: "#iv°", key: "C", want: "F#dim"},
+		{symbol: "vii°7", key: "C", want: "Bdim7"},
+		{symbol: "viiø7", key: "C", want: "Bm7b5"},
+		{symbol: "V7/V", key: "C", want: "D7"},
+		{symbol: "V7/ii", key: "C", want: "A7"},
+		{symbol: "vii°7/V", key: "C", want: "F#dim7"},
+		{symbol: "V/V/V", key: "C", want: "A"},
+		{symbol: "N6", key: "C", want: "Db/F"},
+		{symbol: "N", key: "Am", want: "Bb"},
+		{symbol: "Ger+6", key: "Am", want: "F7"},
+		{symbol: "Fr+6", key: "C", want: "Ab7b5"},
+		{symbol: "i", key: "Am", want: "Am"},
+		{symbol: "VII", key: "Am", want: "G"},
+		{symbol: "vii°7", key: "Am", want: "G#dim7"},
+		{symbol: "III+", key: "Am", want: "Caug"},
+		{symbol: "V7", key: "Eb", want: "Bb7"},
+		{symbol: "IV", key: "F#", want: "B"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.symbol+" in "+test.key, func(t *testing.T) {
+			actual, err := ChordName(test.symbol, test.key)
+			if err != nil {
+				t.Fatalf(`ChordName("%v", "%v") got error: %v`, test.symbol, test.key, err)
+			}
+			if actual != test.want {
+				t.Errorf(`ChordName("%v", "%v"), actual:"%v", want:"%v"`, test.symbol, test.key, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	c, err := Parse("ii7", "D")
+	if err != nil || c.Root != "E" || c.Kind != "m7" || !reflect.DeepEqual(c.Intervals, []int{0, 3, 7, 10}) {
+		t.Errorf(`Parse("ii7", "D"), actual:"%+v". %v`, c, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		symbol string
+		key    string
+		input  string
+		pos    int
+	}{
+		{symbol: "VIII", key: "C", input: "VIII", pos: 3},
+		{symbol: "X", key: "C", input: "X", pos: 0},
+		{symbol: "Iq", key: "C", input: "Iq", pos: 1},
+		{symbol: "viiø9", key: "C", input: "viiø9", pos: 5},
+		{symbol: "V7/", key: "C", input: "", pos: 3},
+		{symbol: "V7/vii°", key: "C", input: "vii°", pos: 3},
+		{symbol: "It+6", key: "C", input: "It+6", pos: 0},
+		{symbol: "V/It6", key: "C", input: "It6", pos: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.symbol, func(t *testing.T) {
+			_, err := Parse(test.symbol, test.key)
+			var pe *note.ParseError
+			if !errors.Is(err, ErrInvalid) || !errors.As(err, &pe) || pe.Input != test.input || pe.Pos != test.pos {
+				t.Errorf(`Parse("%v", "%v") wants Error(%v) at %v. but got "%v"`, test.symbol, test.key, ErrorInvalidNumeral(test.input, test.pos), test.pos, err)
+			}
+		})
+	}
+
+	if _, err := Parse("I", "C4"); !errors.Is(err, note.ErrNotFound) {
+		t.Errorf(`Parse("I", "C4") wants Error(%v). but got "%v"`, note.ErrNotFound, err)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		chordName string
+		key       string
+		want      []Analysis
+	}{
+		{chordName: "Dm7", key: "C", want: []Analysis{{"ii7", Diatonic}}},
+		{chordName: "C", key: "C", want: []Analysis{{"I", Diatonic}}},
+		{chordName: "Bm7b5", key: "C", want: []Analysis{{"viiø7", Diatonic}}},
+		{chordName: "D7", key: "C", want: []Analysis{{"V7/V", Secondary}}},
+		{chordName: "E", key: "C", want: []Analysis{{"V/vi", Secondary}}},
+		{chordName: "F#dim7", key: "C", want: []Analysis{{"vii°7/V", Secondary}}},
+		{chordName: "C#dim7", key: "C", want: []Analysis{{"vii°7/ii", Secondary}}},
+		{chordName: "Bb", key: "C", want: []Analysis{{"bVII", Borrowed}}},
+		{chordName: "Fm", key: "C", want: []Analysis{{"iv", Borrowed}}},
+		{chordName: "Db/F", key: "C", want: []Analysis{{"bII", Borrowed}, {"N6", Chromatic}}},
+		{chordName: "Ab7", key: "C", want: []Analysis{{"Ger+6", Chromatic}}},
+		{chordName: "Ab7b5", key: "C", want: []Analysis{{"Fr+6", Chromatic}}},
+		{chordName: "F#", key: "C", want: []Analysis{{"#IV", Chromatic}}},
+		{chordName: "E7", key: "Am", want: []Analysis{{"V7", Diatonic}}},
+		{chordName: "G#dim7", key: "Am", want: []Analysis{{"vii°7", Diatonic}}},
+		{chordName: "C", key: "Am", want: []Analysis{{"III", Diatonic}}},
+		{chordName: "Bbm7", key: "Eb", want: []Analysis{{"v7", Borrowed}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chordName+" in "+test.key, func(t *testing.T) {
+			actual, err := Analyze(test.chordName, test.key)
+			if err != nil {
+				t.Fatalf(`Analyze("%v", "%v") got error: %v`, test.chordName, test.key, err)
+			}
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Analyze("%v", "%v"), actual:"%v", want:"%v"`, test.chordName, test.key, actual, test.want)
+			}
+			// the last symbol goes back to the chord with its bass
+			if back, err := ChordName(actual[len(actual)-1].Symbol, test.key); err != nil || back != test.chordName {
+				t.Errorf(`ChordName("%v", "%v"), actual:"%v", want:"%v". %v`, actual[len(actual)-1].Symbol, test.key, back, test.chordName, err)
+			}
+		})
+	}
+
+	if _, err := Analyze("CN7", "C"); !errors.Is(err, chord.ErrNotFoundKind) {
+		t.Errorf(`Analyze("CN7", "C") wants Error(%v). but got "%v"`, chord.ErrNotFoundKind, err)
+	}
+}
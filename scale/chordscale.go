@@ -0,0 +1,159 @@
+package scale
+
+import (
+	"sort"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// A scale to play over a chord
+type ChordScale struct {
+	// Name of scale `super-lydian`
+	Scale string
+	// Scale notes from the root of the chord `{"F", "G", "A", "B", "C", "D", "Eb"}`
+	Notes []string
+	// Note numbers from the root of the chord `{0, 2, 4, 6, 7, 9, 10}`
+	Intervals []int
+	// Scale tones out of the chord which are available tensions `{"9", "13"}`
+	Tensions []string
+	// Scale tones out of the chord which are avoid notes `{"11"}`
+	AvoidNotes []string
+}
+
+// Names of scale tones from the root of a chord
+var toneNames = [12]string{"1", "b9", "9", "#9", "3", "11", "#11", "5", "b13", "13", "b7", "7"}
+
+// Degrees of scale tones from the root of a chord to spell them, as `toneNames`
+var toneDegrees = [12]int{1, 2, 2, 2, 3, 4, 4, 5, 6, 6, 7, 7}
+
+// Get scales on the root of a chord `F7(#11)` which contain the chord tones within an octave, and the bass of a slash chord.
+// Tensions written in the chord like `#11` are not required, but a scale without them ranks lower.
+// They are ranked by fewer avoid notes, by fewer missing tensions of the chord, by more available tensions, and then by more scale tones.
+// A scale tone out of the chord is an avoid note if it's a half step above a chord tone,
+// except `b9` and `b13` on a dominant chord. Other scale tones out of the chord are tensions, but the 3rd and the 7th are neither.
+// Chord tones are spelled as in the chord, and other scale tones are spelled by their degrees from the root. i.e. `Cdim7` -> `{"C", "D", "Eb", "F", "Gb", "Ab", "Bbb", "B"}`
+func ChordScales(chordName string) ([]ChordScale, error) {
+	c, err := chord.Parse(chordName)
+	if err != nil {
+		return nil, err
+	}
+
+	spelled, err := chordSpelling(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// chord tones which a scale should contain, and tensions written in the chord
+	var tones, written, inChord [12]bool
+	for _, n := range c.NumberList() {
+		if n < 12 {
+			tones[n] = true
+		} else {
+			written[n%12] = true
+		}
+		inChord[n%12] = true
+	}
+	isDominant := tones[4] && tones[10]
+
+	scalesMu.RLock()
+	candidates := map[string][]int{}
+	for name, sc := range allKindOfScales {
+		candidates[name] = append([]int(nil), sc...)
+	}
+	scalesMu.RUnlock()
+
+	var scales []ChordScale
+	missing := map[string]int{}
+	for name, sc := range candidates {
+		var inScale [12]bool
+		for _, n := range sc {
+			inScale[n] = true
+		}
+		if !containsAll(inScale, tones) {
+			continue
+		}
+		for n, isWritten := range written {
+			if isWritten && !inScale[n] {
+				missing[name]++
+			}
+		}
+
+		cs := ChordScale{Scale: name, Intervals: sc}
+		for _, n := range sc {
+			spelledName, isExists := spelled[n]
+			if !isExists {
+				spelledName, err = note.SpellNote(c.Root, n, toneDegrees[n])
+				if err != nil {
+					return nil, err
+				}
+			}
+			cs.Notes = append(cs.Notes, spelledName)
+
+			if inChord[n] {
+				continue
+			}
+			switch {
+			case inChord[(n+11)%12] && !(isDominant && (n == 1 || n == 8)):
+				cs.AvoidNotes = append(cs.AvoidNotes, toneNames[n])
+			case n != 3 && n != 4 && n != 10 && n != 11 || n == 3 && tones[4]:
+				cs.Tensions = append(cs.Tensions, toneNames[n])
+			}
+		}
+		scales = append(scales, cs)
+	}
+
+	sort.Slice(scales, func(i, j int) bool {
+		a, b := scales[i], scales[j]
+		if len(a.AvoidNotes) != len(b.AvoidNotes) {
+			return len(a.AvoidNotes) < len(b.AvoidNotes)
+		}
+		if missing[a.Scale] != missing[b.Scale] {
+			return missing[a.Scale] < missing[b.Scale]
+		}
+		if len(a.Tensions) != len(b.Tensions) {
+			return len(a.Tensions) > len(b.Tensions)
+		}
+		if len(a.Intervals) != len(b.Intervals) {
+			return len(a.Intervals) > len(b.Intervals)
+		}
+		return a.Scale < b.Scale
+	})
+
+	return scales, nil
+}
+
+// get spelled notes of a chord by intervals from the root within an octave. The bass of a slash chord is as written.
+func chordSpelling(c chord.Chord) (map[int]string, error) {
+	bass := c.Bass
+	c.Bass = ""
+	notes, err := c.SpelledNotes()
+	if err != nil {
+		return nil, err
+	}
+
+	spelled := map[int]string{}
+	for i, n := range c.Intervals {
+		if _, isExists := spelled[n%12]; !isExists {
+			spelled[n%12] = notes[i]
+		}
+	}
+	if bass != "" {
+		b, _ := note.NoteNumber(bass)
+		if _, isExists := spelled[(b-c.RootPitchClass+12)%12]; !isExists {
+			spelled[(b-c.RootPitchClass+12)%12] = bass
+		}
+	}
+
+	return spelled, nil
+}
+
+func containsAll(set [12]bool, subset [12]bool) bool {
+	for n, isIn := range subset {
+		if isIn && !set[n] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package scale
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+)
+
+func TestChordScales(t *testing.T) {
+	tests := []struct {
+		chordName  string
+		want       string
+		notes      []string
+		tensions   []string
+		avoidNotes []string
+	}{
+		{chordName: "F7(#11)", want: "super-lydian", notes: []string{"F", "G", "A", "B", "C", "D", "Eb"}, tensions: []string{"9", "13"}, avoidNotes: nil},
+		{chordName: "C7", want: "super-lydian", notes: []string{"C", "D", "E", "F#", "G", "A", "Bb"}, tensions: []string{"9", "#11", "13"}, avoidNotes: nil},
+		{chordName: "CM7", want: "lydian", notes: []string{"C", "D", "E", "F#", "G", "A", "B"}, tensions: []string{"9", "#11", "13"}, avoidNotes: nil},
+		{chordName: "Dm7", want: "dorian", notes: []string{"D", "E", "F", "G", "A", "B", "C"}, tensions: []string{"9", "11", "13"}, avoidNotes: nil},
+		{chordName: "Bm7b5", want: "super-aeolian", notes: []string{"B", "C#", "D", "E", "F", "G", "A"}, tensions: []string{"9", "11", "b13"}, avoidNotes: nil},
+		{chordName: "Cdim7", want: "diminished", notes: []string{"C", "D", "Eb", "F", "Gb", "Ab", "Bbb", "B"}, tensions: []string{"9", "11", "b13"}, avoidNotes: nil},
+		{chordName: "Ebm7", want: "dorian", notes: []string{"Eb", "F", "Gb", "Ab", "Bb", "C", "Db"}, tensions: []string{"9", "11", "13"}, avoidNotes: nil},
+		{chordName: "G7b9", want: "phrigian-major", notes: []string{"G", "Ab", "B", "C", "D", "Eb", "F"}, tensions: []string{"b13"}, avoidNotes: []string{"11"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chordName, func(t *testing.T) {
+			scales, err := ChordScales(test.chordName)
+			if err != nil {
+				t.Fatalf(`ChordScales("%v") got error: %v`, test.chordName, err)
+			}
+			first := scales[0]
+			if first.Scale != test.want {
+				t.Errorf(`ChordScales("%v"), actual first:"%v", want:"%v"`, test.chordName, first.Scale, test.want)
+			}
+			if !reflect.DeepEqual(first.Notes, test.notes) {
+				t.Errorf(`ChordScales("%v"), actual notes:"%v", want:"%v"`, test.chordName, first.Notes, test.notes)
+			}
+			if !reflect.DeepEqual(first.Tensions, test.tensions) || !reflect.DeepEqual(first.AvoidNotes, test.avoidNotes) {
+				t.Errorf(`ChordScales("%v"), actual tensions:"%v" avoid notes:"%v", want:"%v" "%v"`,
+					test.chordName, first.Tensions, first.AvoidNotes, test.tensions, test.avoidNotes)
+			}
+		})
+	}
+}
+
+func TestChordScalesContainChord(t *testing.T) {
+	scales, err := ChordScales("C7")
+	if err != nil {
+		t.Fatalf(`ChordScales("C7") got error: %v`, err)
+	}
+
+	var names []string
+	for i, s := range scales {
+		names = append(names, s.Scale)
+		if i > 0 && len(scales[i-1].AvoidNotes) > len(s.AvoidNotes) {
+			t.Errorf(`ChordScales("C7") should be ranked by avoid notes, actual:"%v" before "%v"`, scales[i-1].Scale, s.Scale)
+		}
+		if s.Scale == "mixolydian" && !reflect.DeepEqual(s.AvoidNotes, []string{"11"}) {
+			t.Errorf(`ChordScales("C7"), mixolydian actual avoid notes:"%v"`, s.AvoidNotes)
+		}
+	}
+	for _, name := range []string{"ionian", "dorian", "whole-tone", "super-locrian"} {
+		for _, actual := range names {
+			if actual == name {
+				t.Errorf(`ChordScales("C7") should not have "%v" which doesn't contain all chord tones`, name)
+			}
+		}
+	}
+}
+
+func TestChordScalesWrittenTensions(t *testing.T) {
+	// `C7(#11)` has `#9` too, but no scale which contains the chord tones has both of them
+	scales, err := ChordScales("C7(#11)")
+	if err != nil {
+		t.Fatalf(`ChordScales("C7(#11)") got error: %v`, err)
+	}
+	if want := []string{"C", "D", "E", "F#", "G", "A", "Bb"}; scales[0].Scale != "super-lydian" || !reflect.DeepEqual(scales[0].Notes, want) {
+		t.Errorf(`ChordScales("C7(#11)"), actual first:"%v" %v, want:"super-lydian" %v`, scales[0].Scale, scales[0].Notes, want)
+	}
+
+	// the bass of a slash chord is spelled as written
+	scales, err = ChordScales("Ab/C")
+	if err != nil {
+		t.Fatalf(`ChordScales("Ab/C") got error: %v`, err)
+	}
+	for _, s := range scales {
+		if s.Scale == "ionian" && !reflect.DeepEqual(s.Notes, []string{"Ab", "Bb", "C", "Db", "Eb", "F", "G"}) {
+			t.Errorf(`ChordScales("Ab/C"), ionian actual notes:"%v"`, s.Notes)
+		}
+	}
+}
+
+func TestChordScalesError(t *testing.T) {
+	if _, err := ChordScales("CN7"); !errors.Is(err, chord.ErrNotFoundKind) {
+		t.Errorf(`ChordScales("CN7"), actual error:%v`, err)
+	}
+}
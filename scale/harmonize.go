@@ -0,0 +1,105 @@
+package scale
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Sizes of chords to stack on degrees of a scale
+const (
+	Triad   = 3
+	Seventh = 4
+	Ninth   = 5
+)
+
+var ErrInvalidSize = errors.New("Size of chord should be 3 to 5")
+
+var ErrorInvalidSize = func(size int) error { return fmt.Errorf("%w. `%d`", ErrInvalidSize, size) }
+
+// A chord stacked on a degree of a scale
+type DiatonicChord struct {
+	// Degree of the scale from `1`
+	Degree int
+	// Note name of the root `F#`
+	Root string
+	// Note numbers from the root `{0, 3, 7, 10}`
+	Intervals []int
+	// Kind of chord `m7`. It's empty for the major triad and for a stack which has no name.
+	Kind string
+	// Full chord name `F#m7`. It's empty for a stack which has no name.
+	Symbol string
+}
+
+// Whether the stack matches a known kind of chord
+func (d DiatonicChord) IsNamed() bool {
+	return d.Symbol != ""
+}
+
+// Get chords stacking every other note on each degree of a scale `ionian` on a root note `D`.
+// `size` is the number of notes of each chord, `Triad`, `Seventh` or `Ninth`. `ionian, D, 4` -> `DM7`, `Em7`, `F#m7`, ...
+// Roots are spelled by letters on a 7 notes scale. A stack which doesn't match any kind of chord has no symbol i.e. on `whole-tone`.
+func Harmonize(scaleName string, rootNote string, size int) ([]DiatonicChord, error) {
+	if size < Triad || size > Ninth {
+		return nil, ErrorInvalidSize(size)
+	}
+
+	sc, err := GetScale(scaleName)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := note.Parse(rootNote)
+	if err != nil {
+		return nil, err
+	}
+	root.HasOctave = false
+
+	var chords []DiatonicChord
+	for i := range sc {
+		degreeRoot, err := spellDegree(root, sc, i)
+		if err != nil {
+			return nil, err
+		}
+
+		d := DiatonicChord{Degree: i + 1, Root: degreeRoot, Intervals: stackThirds(sc, i, size)}
+		if kind, err := chord.GetChordKind(d.Intervals); err == nil {
+			d.Kind = kind
+			d.Symbol = degreeRoot + kind
+		}
+		chords = append(chords, d)
+	}
+
+	return chords, nil
+}
+
+// stack every other note of a scale from the index `i`. The result is note numbers from the note of `i`.
+func stackThirds(sc []int, i int, size int) []int {
+	var stack []int
+	for k := 0; k < size; k++ {
+		j := i + k*2
+		stack = append(stack, sc[j%len(sc)]+12*(j/len(sc))-sc[i])
+	}
+
+	return stack
+}
+
+// spell the note on the index `i` of a scale. A double accidental is simplified to be a chord root.
+func spellDegree(root note.Note, sc []int, i int) (string, error) {
+	if len(sc) != len(note.Letters) {
+		n, err := root.Transpose(sc[i])
+		if err != nil {
+			return "", err
+		}
+		return n.String(), nil
+	}
+
+	n, err := root.TransposeByInterval(sc[i], i+1)
+	if err != nil {
+		return "", err
+	}
+
+	return n.Simplify(root.Accidental < 0).String(), nil
+}
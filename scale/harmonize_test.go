@@ -0,0 +1,64 @@
+package scale
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestHarmonize(t *testing.T) {
+	tests := []struct {
+		scaleName string
+		root      string
+		size      int
+		want      []string
+	}{
+		{scaleName: "ionian", root: "D", size: Seventh, want: []string{"DM7", "Em7", "F#m7", "GM7", "A7", "Bm7", "C#m7b5"}},
+		{scaleName: "ionian", root: "C", size: Triad, want: []string{"C", "Dm", "Em", "F", "G", "Am", "Bdim"}},
+		{scaleName: "ionian", root: "C", size: Ninth, want: []string{"CM9", "Dm9", "", "FM9", "G9", "Am9", ""}},
+		{scaleName: "harmonic-minor", root: "A", size: Seventh, want: []string{"AmM7", "Bm7b5", "CaugM7", "Dm7", "E7", "FM7", "G#dim7"}},
+		{scaleName: "dorian", root: "Eb", size: Seventh, want: []string{"Ebm7", "Fm7", "GbM7", "Ab7", "Bbm7", "Cm7b5", "DbM7"}},
+		{scaleName: "whole-tone", root: "C", size: Triad, want: []string{"Caug", "Daug", "Eaug", "F#aug", "G#aug", "A#aug"}},
+		{scaleName: "whole-tone", root: "C", size: Seventh, want: []string{"", "", "", "", "", ""}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scaleName+" "+test.root, func(t *testing.T) {
+			chords, err := Harmonize(test.scaleName, test.root, test.size)
+			if err != nil {
+				t.Fatalf(`Harmonize("%v", "%v", %v) got error: %v`, test.scaleName, test.root, test.size, err)
+			}
+			var actual []string
+			for i, c := range chords {
+				if c.Degree != i+1 || c.IsNamed() != (c.Symbol != "") || len(c.Intervals) != test.size {
+					t.Errorf(`Harmonize("%v", "%v", %v), wrong chord:"%+v"`, test.scaleName, test.root, test.size, c)
+				}
+				actual = append(actual, c.Symbol)
+			}
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Harmonize("%v", "%v", %v), actual:"%v", want:"%v"`, test.scaleName, test.root, test.size, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestHarmonizeIntervals(t *testing.T) {
+	chords, _ := Harmonize("whole-tone", "C", Seventh)
+	if chords[3].Root != "F#" || !reflect.DeepEqual(chords[3].Intervals, []int{0, 4, 8, 12}) {
+		t.Errorf(`Harmonize("whole-tone", "C", 4), 4th degree is wrong:"%+v"`, chords[3])
+	}
+}
+
+func TestHarmonizeError(t *testing.T) {
+	if _, err := Harmonize("ionian", "C", 6); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf(`Harmonize() with size 6 wants Error(%v). but got "%v"`, ErrInvalidSize, err)
+	}
+	if _, err := Harmonize("foo", "C", Triad); !errors.Is(err, ErrNotFound) {
+		t.Errorf(`Harmonize() of "foo" wants Error(%v). but got "%v"`, ErrNotFound, err)
+	}
+	if _, err := Harmonize("ionian", "X", Triad); !errors.Is(err, note.ErrNotFound) {
+		t.Errorf(`Harmonize() on "X" wants Error(%v). but got "%v"`, note.ErrNotFound, err)
+	}
+}
@@ -0,0 +1,293 @@
+package scale
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Weights of 12 pitch classes from the tonic on the major key and on the minor key
+type Profile struct {
+	Name  string
+	Major [12]float64
+	Minor [12]float64
+	// Weights of other scales by name `dorian`. A mode of the major scale without them is the major weights rotated to it.
+	Scales map[string][12]float64
+}
+
+// Key profiles
+var (
+	// Krumhansl and Kessler (1982)
+	Krumhansl = Profile{
+		Name:  "krumhansl",
+		Major: [12]float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88},
+		Minor: [12]float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17},
+	}
+	// Temperley (2007) from the Kostka-Payne corpus
+	Temperley = Profile{
+		Name:  "temperley",
+		Major: [12]float64{0.748, 0.060, 0.488, 0.082, 0.670, 0.460, 0.096, 0.715, 0.104, 0.366, 0.057, 0.400},
+		Minor: [12]float64{0.712, 0.084, 0.474, 0.618, 0.049, 0.460, 0.105, 0.747, 0.404, 0.067, 0.133, 0.330},
+	}
+	// Aarden (2003) from the Essen folksong collection
+	Aarden = Profile{
+		Name:  "aarden",
+		Major: [12]float64{17.7661, 0.145624, 14.9265, 0.160186, 19.8049, 11.3587, 0.291248, 22.062, 0.145624, 8.15494, 0.232998, 4.95122},
+		Minor: [12]float64{18.2648, 0.737619, 14.0499, 16.8599, 0.702494, 14.4362, 0.702494, 18.6161, 4.56621, 1.93186, 7.37619, 1.75623},
+	}
+)
+
+// Major and minor
+var MajorAndMinor = []string{"ionian", "aeolian"}
+
+// Church modes
+var ChurchModes = []string{"ionian", "dorian", "phrigian", "lydian", "mixolydian", "aeolian", "locrian"}
+
+// Options of key detection
+type DetectOptions struct {
+	// Key profile. `Krumhansl` by default.
+	Profile Profile
+	// Names of scales to be candidates. `MajorAndMinor` by default.
+	Scales []string
+}
+
+// A candidate of key
+type KeyCandidate struct {
+	// Note name of the tonic `Eb`
+	Tonic string
+	// Name of scale `ionian`
+	Scale string
+	// Correlation with the profile, -1 to 1
+	Score float64
+}
+
+var (
+	ErrNoNotes          = errors.New("Need notes to detect key")
+	ErrInvalidDurations = errors.New("Durations should be as many as notes, and not negative")
+	ErrNoProfile        = errors.New("Not found weights of scale in the profile")
+)
+
+var (
+	ErrorInvalidDurations = func(notes int, durations int) error {
+		return fmt.Errorf("%w. notes:%d, durations:%d", ErrInvalidDurations, notes, durations)
+	}
+	ErrorNoProfile = func(scaleName string, profile Profile) error {
+		return fmt.Errorf("%w. `%s` in %s", ErrNoProfile, scaleName, profile.Name)
+	}
+)
+
+// Detect keys from note numbers `{60, 64, 67}`, ranked by score. Notes are weighted by `durations` if it's not nil.
+// `ionian` and `aeolian` take the major and the minor weights of the profile. With other modes, every mode of the major scale
+// takes the major weights rotated to it, so modes of the same notes get the same score, and the one whose tonic, then its 5th, is weighted the most comes first.
+// A scale which is not a mode of the major scale needs its weights in `Profile.Scales`. Tonics on black keys are spelled as `Db`, `Eb`, `F#`, `Ab` and `Bb`.
+func DetectKey(noteNumbers []int, durations []float64, opts DetectOptions) ([]KeyCandidate, error) {
+	if durations != nil && len(durations) != len(noteNumbers) {
+		return nil, ErrorInvalidDurations(len(noteNumbers), len(durations))
+	}
+
+	var histogram [12]float64
+	for i, n := range noteNumbers {
+		if n < note.MinimumNoteNumber || n > note.MaximumNoteNumber {
+			return nil, note.ErrorOutOfRange
+		}
+		weight := 1.0
+		if durations != nil {
+			weight = durations[i]
+		}
+		if weight < 0 {
+			return nil, ErrorInvalidDurations(len(noteNumbers), len(durations))
+		}
+		histogram[n%12] += weight
+	}
+
+	return detect(histogram, opts)
+}
+
+// Detect keys from full chord names `{"Dm7", "G7", "CM7"}`, ranked by score. Chords are weighted by `durations` if it's not nil.
+func DetectKeyFromChords(chordNames []string, durations []float64, opts DetectOptions) ([]KeyCandidate, error) {
+	if durations != nil && len(durations) != len(chordNames) {
+		return nil, ErrorInvalidDurations(len(chordNames), len(durations))
+	}
+
+	var noteNumbers []int
+	var noteDurations []float64
+	for i, chordName := range chordNames {
+		notes, err := chord.GetChord(chordName)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range notes {
+			number, _ := note.NoteNumber(n)
+			noteNumbers = append(noteNumbers, number)
+			if durations != nil {
+				noteDurations = append(noteDurations, durations[i])
+			}
+		}
+	}
+
+	return DetectKey(noteNumbers, noteDurations, opts)
+}
+
+func detect(histogram [12]float64, opts DetectOptions) ([]KeyCandidate, error) {
+	total := 0.0
+	for _, w := range histogram {
+		total += w
+	}
+	if total == 0 {
+		return nil, ErrNoNotes
+	}
+
+	profile := opts.Profile
+	if profile.Name == "" {
+		profile = Krumhansl
+	}
+	scaleNames := opts.Scales
+	if scaleNames == nil {
+		scaleNames = MajorAndMinor
+	}
+
+	isModal := false
+	for _, scaleName := range scaleNames {
+		if name := strings.ToLower(scaleName); name != "ionian" && name != "aeolian" {
+			isModal = true
+		}
+	}
+
+	type ranked struct {
+		KeyCandidate
+		tonicWeight float64
+		fifthWeight float64
+	}
+	var list []ranked
+	for _, scaleName := range scaleNames {
+		weights, err := scaleProfile(profile, scaleName, isModal)
+		if err != nil {
+			return nil, err
+		}
+		sc, _ := GetScale(scaleName)
+		for tonic := 0; tonic < 12; tonic++ {
+			var rotated [12]float64
+			for pc := range rotated {
+				rotated[pc] = histogram[(pc+tonic)%12]
+			}
+			candidate := KeyCandidate{
+				Tonic: tonicName(tonic),
+				Scale: scaleName,
+				Score: correlation(rotated, weights),
+			}
+			list = append(list, ranked{candidate, rotated[0], rotated[fifthOf(sc)]})
+		}
+	}
+
+	// Modes of the same notes have the same score except rounding errors, and the tonic and its 5th decide the order.
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if math.Abs(a.Score-b.Score) > 1e-9 {
+			return a.Score > b.Score
+		}
+		if a.tonicWeight != b.tonicWeight {
+			return a.tonicWeight > b.tonicWeight
+		}
+		return a.fifthWeight > b.fifthWeight
+	})
+
+	candidates := make([]KeyCandidate, len(list))
+	for i, r := range list {
+		candidates[i] = r.KeyCandidate
+	}
+
+	return candidates, nil
+}
+
+// get weights of a scale in a profile. A mode of the major scale is the major weights rotated to it, and so is `aeolian` among other modes.
+func scaleProfile(profile Profile, scaleName string, isModal bool) ([12]float64, error) {
+	sc, err := GetScale(scaleName)
+	if err != nil {
+		return [12]float64{}, err
+	}
+
+	name := strings.ToLower(scaleName)
+	if weights, isExists := profile.Scales[name]; isExists {
+		return weights, nil
+	}
+	if name == "aeolian" && !isModal {
+		return profile.Minor, nil
+	}
+
+	offset, ok := modeOfMajor(sc)
+	if !ok {
+		return [12]float64{}, ErrorNoProfile(scaleName, profile)
+	}
+	var weights [12]float64
+	for pc := range weights {
+		weights[pc] = profile.Major[(pc+offset)%12]
+	}
+
+	return weights, nil
+}
+
+// get the interval from the tonic of the major scale to the tonic of a mode `dorian` -> `2`, if the scale is a mode of the major scale
+func modeOfMajor(sc []int) (int, bool) {
+	major, _ := GetScale("ionian")
+	if len(sc) != len(major) {
+		return 0, false
+	}
+
+	for degree, offset := range major {
+		isMode := true
+		for i, n := range sc {
+			if n != (major[(degree+i)%len(major)]-offset+12)%12 {
+				isMode = false
+				break
+			}
+		}
+		if isMode {
+			return offset, true
+		}
+	}
+
+	return 0, false
+}
+
+// get the 5th degree of a scale `{0, 2, 3, 5, 6, 8, 10}` -> `6`, or the tonic on a scale with less than 5 notes
+func fifthOf(sc []int) int {
+	if len(sc) < 5 {
+		return 0
+	}
+
+	return sc[4]
+}
+
+// Pearson correlation coefficient
+func correlation(a [12]float64, b [12]float64) float64 {
+	var meanA, meanB float64
+	for i := range a {
+		meanA += a[i] / 12
+		meanB += b[i] / 12
+	}
+
+	var cov, varA, varB float64
+	for i := range a {
+		cov += (a[i] - meanA) * (b[i] - meanB)
+		varA += (a[i] - meanA) * (a[i] - meanA)
+		varB += (b[i] - meanB) * (b[i] - meanB)
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+
+	return cov / math.Sqrt(varA*varB)
+}
+
+func tonicName(pitchClass int) string {
+	if pitchClass == 6 {
+		return note.BaseTones[pitchClass]
+	}
+
+	return note.FlatTones[pitchClass]
+}
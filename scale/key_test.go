@@ -0,0 +1,164 @@
+package scale
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestDetectKey(t *testing.T) {
+	tests := []struct {
+		title       string
+		noteNumbers []int
+		durations   []float64
+		opts        DetectOptions
+		tonic       string
+		scale       string
+	}{
+		{
+			title:       "C major scale",
+			noteNumbers: []int{60, 62, 64, 65, 67, 69, 71, 72},
+			tonic:       "C",
+			scale:       "ionian",
+		},
+		{
+			title:       "A harmonic minor scale by temperley",
+			noteNumbers: []int{57, 59, 60, 62, 64, 65, 68, 69},
+			opts:        DetectOptions{Profile: Temperley},
+			tonic:       "A",
+			scale:       "aeolian",
+		},
+		{
+			title:       "weighted by durations",
+			noteNumbers: []int{57, 60, 64, 67},
+			durations:   []float64{0.5, 4, 4, 4},
+			tonic:       "C",
+			scale:       "ionian",
+		},
+		{
+			title:       "A wins without durations",
+			noteNumbers: []int{57, 60, 64, 57, 57},
+			tonic:       "A",
+			scale:       "aeolian",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			candidates, err := DetectKey(test.noteNumbers, test.durations, test.opts)
+			if err != nil {
+				t.Fatalf(`DetectKey(%v) got error: %v`, test.noteNumbers, err)
+			}
+			if len(candidates) != 12*2 {
+				t.Errorf(`DetectKey(%v) should have 24 candidates, but %v`, test.noteNumbers, len(candidates))
+			}
+			if actual := candidates[0]; actual.Tonic != test.tonic || actual.Scale != test.scale {
+				t.Errorf(`DetectKey(%v), actual:"%v", want:"%v %v"`, test.noteNumbers, actual, test.tonic, test.scale)
+			}
+			for i := 1; i < len(candidates); i++ {
+				if candidates[i-1].Score < candidates[i].Score {
+					t.Errorf(`DetectKey(%v) is not ranked. "%v", "%v"`, test.noteNumbers, candidates[i-1], candidates[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDetectKeyFromChords(t *testing.T) {
+	tests := []struct {
+		chordNames []string
+		durations  []float64
+		opts       DetectOptions
+		tonic      string
+		scale      string
+	}{
+		{chordNames: []string{"Eb", "Ab", "Bb7", "Eb"}, tonic: "Eb", scale: "ionian"},
+		{chordNames: []string{"Am", "Dm", "E7", "Am"}, opts: DetectOptions{Profile: Aarden}, tonic: "A", scale: "aeolian"},
+		{chordNames: []string{"Dm", "C", "G", "Dm"}, opts: DetectOptions{Profile: Temperley, Scales: ChurchModes}, tonic: "D", scale: "dorian"},
+		{chordNames: []string{"G", "F", "C", "G"}, opts: DetectOptions{Profile: Temperley, Scales: ChurchModes}, tonic: "G", scale: "mixolydian"},
+		// ii-V-I
+		{chordNames: []string{"Dm7", "G7", "CM7"}, tonic: "C", scale: "ionian"},
+		{chordNames: []string{"Dm7", "G7", "CM7"}, opts: DetectOptions{Scales: ChurchModes}, tonic: "C", scale: "ionian"},
+		{chordNames: []string{"Dm7", "G7", "CM7"}, opts: DetectOptions{Profile: Aarden, Scales: ChurchModes}, tonic: "C", scale: "ionian"},
+		// i-iv-V
+		{chordNames: []string{"Cm", "Fm", "G7", "Cm"}, tonic: "C", scale: "aeolian"},
+		{chordNames: []string{"Am", "Dm", "E7", "Am"}, opts: DetectOptions{Profile: Temperley}, tonic: "A", scale: "aeolian"},
+		{chordNames: []string{"Am", "Dm", "Em", "Am"}, opts: DetectOptions{Scales: ChurchModes}, tonic: "A", scale: "aeolian"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.chordNames, test.opts.Scales), func(t *testing.T) {
+			candidates, err := DetectKeyFromChords(test.chordNames, test.durations, test.opts)
+			if err != nil {
+				t.Fatalf(`DetectKeyFromChords(%v) got error: %v`, test.chordNames, err)
+			}
+			if actual := candidates[0]; actual.Tonic != test.tonic || actual.Scale != test.scale {
+				t.Errorf(`DetectKeyFromChords(%v), actual:"%v", want:"%v %v"`, test.chordNames, candidates[:3], test.tonic, test.scale)
+			}
+		})
+	}
+}
+
+func TestScaleProfile(t *testing.T) {
+	major, _ := scaleProfile(Krumhansl, "ionian", false)
+	if major != Krumhansl.Major {
+		t.Errorf(`scaleProfile("ionian") should be the major profile. "%v"`, major)
+	}
+
+	minor, _ := scaleProfile(Krumhansl, "aeolian", false)
+	if minor != Krumhansl.Minor {
+		t.Errorf(`scaleProfile("aeolian") should be the minor profile. "%v"`, minor)
+	}
+
+	tests := []struct {
+		scaleName string
+		offset    int
+	}{
+		{scaleName: "dorian", offset: 2},
+		{scaleName: "mixolydian", offset: 7},
+		{scaleName: "aeolian", offset: 9},
+		{scaleName: "locrian", offset: 11},
+	}
+	for _, test := range tests {
+		weights, _ := scaleProfile(Krumhansl, test.scaleName, true)
+		for pc := range weights {
+			if weights[pc] != Krumhansl.Major[(pc+test.offset)%12] {
+				t.Errorf(`scaleProfile("%v") should be the major profile rotated by %v. "%v"`, test.scaleName, test.offset, weights)
+				break
+			}
+		}
+	}
+
+	dorian := [12]float64{5, 0, 2, 3, 0, 2, 0, 4, 0, 2, 3, 0}
+	weights, _ := scaleProfile(Profile{Name: "own", Scales: map[string][12]float64{"dorian": dorian}}, "Dorian", true)
+	if weights != dorian {
+		t.Errorf(`scaleProfile("Dorian") should be the weights in the profile. "%v"`, weights)
+	}
+}
+
+func TestDetectKeyError(t *testing.T) {
+	if _, err := DetectKey(nil, nil, DetectOptions{}); !errors.Is(err, ErrNoNotes) {
+		t.Errorf(`DetectKey(nil) wants Error(%v). but got "%v"`, ErrNoNotes, err)
+	}
+	if _, err := DetectKey([]int{60, 64}, []float64{1}, DetectOptions{}); !errors.Is(err, ErrInvalidDurations) {
+		t.Errorf(`DetectKey() with 1 duration wants Error(%v). but got "%v"`, ErrInvalidDurations, err)
+	}
+	if _, err := DetectKey([]int{60, 64}, []float64{1, -1}, DetectOptions{}); !errors.Is(err, ErrInvalidDurations) {
+		t.Errorf(`DetectKey() with a negative duration wants Error(%v). but got "%v"`, ErrInvalidDurations, err)
+	}
+	if _, err := DetectKey([]int{60, 128}, nil, DetectOptions{}); !errors.Is(err, note.ErrOutOfRange) {
+		t.Errorf(`DetectKey() with 128 wants Error(%v). but got "%v"`, note.ErrOutOfRange, err)
+	}
+	if _, err := DetectKey([]int{60}, nil, DetectOptions{Scales: []string{"foo"}}); !errors.Is(err, ErrNotFound) {
+		t.Errorf(`DetectKey() on "foo" wants Error(%v). but got "%v"`, ErrNotFound, err)
+	}
+	if _, err := DetectKey([]int{60}, nil, DetectOptions{Scales: []string{"harmonic-minor"}}); !errors.Is(err, ErrNoProfile) {
+		t.Errorf(`DetectKey() on "harmonic-minor" wants Error(%v). but got "%v"`, ErrNoProfile, err)
+	}
+	if _, err := DetectKeyFromChords([]string{"CN7"}, nil, DetectOptions{}); !errors.Is(err, chord.ErrNotFoundKind) {
+		t.Errorf(`DetectKeyFromChords() of "CN7" wants Error(%v). but got "%v"`, chord.ErrNotFoundKind, err)
+	}
+}
@@ -0,0 +1,63 @@
+package scale
+
+import (
+	"strings"
+	"sync"
+)
+
+// It guards `allKindOfScales` and `AllScales`
+var scalesMu sync.RWMutex
+
+// Register an original scale `hirajoshi` with a note number list `{0, 2, 3, 7, 8}`, and its aliases `hira-joshi`.
+// Names are case-insensitive as `GetScale()`. Note numbers should start from 0 and go up within 0 to 11. It's safe for concurrent use.
+func RegisterScale(scaleName string, scaleNumbers []int, aliases ...string) error {
+	var names []string
+	for _, name := range append([]string{scaleName}, aliases...) {
+		if name == "" {
+			return ErrorInvalidScale(name, "empty name")
+		}
+		names = append(names, strings.ToLower(name))
+	}
+	if err := validateScaleNumbers(scaleName, scaleNumbers); err != nil {
+		return err
+	}
+
+	scalesMu.Lock()
+	defer scalesMu.Unlock()
+
+	seen := map[string]bool{}
+	for _, name := range names {
+		if _, isExists := allKindOfScales[name]; isExists || seen[name] {
+			return ErrorDuplicateScale(name)
+		}
+		seen[name] = true
+	}
+
+	numbers := append([]int{}, scaleNumbers...)
+	for _, name := range names {
+		allKindOfScales[name] = numbers
+	}
+	// a new slice, so a slice which is got before is not changed
+	AllScales = append(append([]string{}, AllScales...), names...)
+
+	return nil
+}
+
+// Get all scales including registered ones. It's safe for concurrent use.
+func ListScales() []string {
+	return allScales()
+}
+
+// Note numbers should start from 0, and go up within 0 to 11.
+func validateScaleNumbers(scaleName string, scaleNumbers []int) error {
+	if len(scaleNumbers) == 0 || scaleNumbers[0] != 0 {
+		return ErrorInvalidScale(scaleName, "note numbers should start from 0")
+	}
+	for i := 1; i < len(scaleNumbers); i++ {
+		if scaleNumbers[i] <= scaleNumbers[i-1] || scaleNumbers[i] > 11 {
+			return ErrorInvalidScale(scaleName, "note numbers should go up within 0 to 11")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package scale
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// remove registered scales not to affect other tests
+func unregisterScales(t *testing.T, names ...string) {
+	t.Cleanup(func() {
+		scalesMu.Lock()
+		defer scalesMu.Unlock()
+		for _, name := range names {
+			delete(allKindOfScales, name)
+		}
+		var list []string
+		for k := range allKindOfScales {
+			list = append(list, k)
+		}
+		AllScales = list
+	})
+}
+
+func TestRegisterScale(t *testing.T) {
+	unregisterScales(t, "hirajoshi", "hira-joshi")
+
+	if err := RegisterScale("Hirajoshi", []int{0, 2, 3, 7, 8}, "hira-joshi"); err != nil {
+		t.Fatalf(`RegisterScale() got error: %v`, err)
+	}
+
+	for _, name := range []string{"hirajoshi", "HIRAJOSHI", "hira-joshi"} {
+		actual, err := GetScale(name)
+		if err != nil || !reflect.DeepEqual(actual, []int{0, 2, 3, 7, 8}) {
+			t.Errorf(`GetScale("%v"), actual:"%v", err:"%v"`, name, actual, err)
+		}
+	}
+
+	if len(AllScales) != 31 || len(ListScales()) != 31 {
+		t.Errorf(`AllScales doesn't reflect registrations. %v, %v`, len(AllScales), len(ListScales()))
+	}
+}
+
+func TestRegisterScaleCopy(t *testing.T) {
+	unregisterScales(t, "copied")
+
+	if err := RegisterScale("copied", []int{0, 2, 3, 7, 8}); err != nil {
+		t.Fatalf(`RegisterScale() got error: %v`, err)
+	}
+	got, _ := GetScale("copied")
+	got[1] = 1
+	for _, cs := range mustChordScales(t, "Cm") {
+		if cs.Scale == "copied" {
+			cs.Intervals[1] = 1
+		}
+	}
+
+	if again, _ := GetScale("copied"); !reflect.DeepEqual(again, []int{0, 2, 3, 7, 8}) {
+		t.Errorf(`The registered scale should not be changed by callers, actual:"%v"`, again)
+	}
+}
+
+func mustChordScales(t *testing.T, chordName string) []ChordScale {
+	t.Helper()
+
+	scales, err := ChordScales(chordName)
+	if err != nil {
+		t.Fatalf(`ChordScales("%v") got error: %v`, chordName, err)
+	}
+
+	return scales
+}
+
+func TestRegisterScaleError(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []int
+		aliases []string
+		want    error
+	}{
+		{name: "", numbers: []int{0, 2}, want: ErrInvalidScale},
+		{name: "x", numbers: []int{}, want: ErrInvalidScale},
+		{name: "x", numbers: []int{2, 4}, want: ErrInvalidScale},
+		{name: "x", numbers: []int{0, 4, 2}, want: ErrInvalidScale},
+		{name: "x", numbers: []int{0, 4, 12}, want: ErrInvalidScale},
+		{name: "Dorian", numbers: []int{0, 2}, want: ErrDuplicateScale},
+		{name: "x", numbers: []int{0, 2}, aliases: []string{"X"}, want: ErrDuplicateScale},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.name, test.numbers, test.aliases), func(t *testing.T) {
+			err := RegisterScale(test.name, test.numbers, test.aliases...)
+			if !errors.Is(err, test.want) {
+				t.Errorf(`RegisterScale("%v", %v, %v) wants Error(%v). but got "%v"`, test.name, test.numbers, test.aliases, test.want, err)
+			}
+		})
+	}
+}
+
+func TestRegisterScaleConcurrently(t *testing.T) {
+	var names []string
+	for i := 0; i < 20; i++ {
+		names = append(names, fmt.Sprintf("concurrent%d", i))
+	}
+	unregisterScales(t, names...)
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(2)
+		go func(name string) {
+			defer wg.Done()
+			if err := RegisterScale(name, []int{0, 2, 4}); err != nil {
+				t.Errorf(`RegisterScale("%v") got error: %v`, name, err)
+			}
+		}(name)
+		go func() {
+			defer wg.Done()
+			_, _ = GetScale("ionian")
+			_ = ListScales()
+		}()
+	}
+	wg.Wait()
+
+	if len(ListScales()) != 49 {
+		t.Errorf(`Concurrent registrations are lost. %v`, len(ListScales()))
+	}
+}
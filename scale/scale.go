@@ -1,6 +1,7 @@
 package scale
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -13,8 +14,6 @@ import (
 // |__|___|__|__|___|___|__|  |__|___|__|__|___|___|__|  |__|___|__|__|___|___|__|
 //  0   2  4   5  7   9  11    C   D  E   F  G   A   B    C   D  E   F  G   A   B
 
-const allScale = 29
-
 var allKindOfScales = map[string][]int{
 	// Major
 	"ionian":     {0, 2, 4, 5, 7, 9, 11}, // C D E F G A B
@@ -63,33 +62,48 @@ var allKindOfScales = map[string][]int{
 	"blue-note": {0, 2, 3, 4, 5, 6, 7, 9, 10, 11}, // C D Eb E F Gb G A Bb B
 }
 
-func allScales() [allScale]string {
-	var list [allScale]string
-	i := 0
+func allScales() []string {
+	scalesMu.RLock()
+	defer scalesMu.RUnlock()
+
+	var list []string
 	for k := range allKindOfScales {
-		list[i] = k
-		i++
+		list = append(list, k)
 	}
 
 	return list
 }
 
-// All scales
+// All scales. It's updated by `RegisterScale()`, use `ListScales()` while registering concurrently.
 var AllScales = allScales()
 
+// Sentinel errors. Errors from this package wrap one of them, or an error of `note`.
+var (
+	ErrNotFound       = errors.New("Not found scale")
+	ErrInvalidScale   = errors.New("Invalid scale")
+	ErrDuplicateScale = errors.New("Duplicate scale")
+)
+
 var (
-	ErrorNotFoundScale = func(scaleName string) error { return fmt.Errorf("Not found scale. `%s`", scaleName) }
+	ErrorNotFoundScale = func(scaleName string) error { return note.NewParseError(scaleName, 0, ErrNotFound) }
+	ErrorInvalidScale = func(scaleName string, reason string) error {
+		return fmt.Errorf("%w. `%s`, %s", ErrInvalidScale, scaleName, reason)
+	}
+	ErrorDuplicateScale = func(scaleName string) error { return fmt.Errorf("%w. `%s`", ErrDuplicateScale, scaleName) }
 )
 
 // Get scale notes from scale name: `ionian` -> `{0, 2, 4, 5, 7, 9, 11}`
 func GetScale(scaleName string) ([]int, error) {
+	scalesMu.RLock()
 	sc, isExists := allKindOfScales[strings.ToLower(scaleName)]
+	scalesMu.RUnlock()
 
 	if !isExists {
 		return nil, ErrorNotFoundScale(scaleName)
 	}
 
-	return sc, nil
+	// a copy, not to change the registered one
+	return append([]int(nil), sc...), nil
 }
 
 // Get scale notes as note number from the root note: `ionian, D4` -> `{62, 64, 66, 67, 69, 71, 73}`
@@ -111,3 +125,18 @@ func GetScaleFromRoot(scaleName string, rootNote string) ([]int, error) {
 
 	return actualScale, nil
 }
+
+// Transpose scale notes as note number `{62, 64, 66}` by semitones `-2` -> `{60, 62, 64}`. i.e. a result of `GetScaleFromRoot`
+// It's an error if a note goes out of `note.MinimumNoteNumber` to `note.MaximumNoteNumber`.
+func TransposeScale(scaleNumbers []int, semitones int) ([]int, error) {
+	var transposed []int
+	for _, v := range scaleNumbers {
+		n := v + semitones
+		if n < note.MinimumNoteNumber || n > note.MaximumNoteNumber {
+			return nil, note.ErrorOutOfRange
+		}
+		transposed = append(transposed, n)
+	}
+
+	return transposed, nil
+}
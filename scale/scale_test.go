@@ -1,6 +1,11 @@
 package scale
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+)
 
 func TestAllScales(t *testing.T) {
 	if len(allScales()) != 29 {
@@ -100,3 +105,44 @@ func TestGetScaleFromRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestTransposeScale(t *testing.T) {
+	sc, _ := GetScaleFromRoot("ionian", "D4")
+	actual, err := TransposeScale(sc, -2)
+	if err != nil {
+		t.Fatalf(`TransposeScale() got error: %v`, err)
+	}
+	want := []int{60, 62, 64, 65, 67, 69, 71}
+	if len(want) != len(actual) {
+		t.Errorf(`TransposeScale(), actual:"%v", want:"%v"`, actual, want)
+	}
+	for i, v := range actual {
+		if want[i] != v {
+			t.Errorf(`TransposeScale(), actual:"%v", want:"%v"`, actual, want)
+		}
+	}
+
+	if _, err := TransposeScale([]int{120, 127}, 1); err != note.ErrorOutOfRange {
+		t.Errorf(`TransposeScale() wants Error(%v). but got "%v"`, note.ErrorOutOfRange, err)
+	}
+	if _, err := TransposeScale([]int{0, 2}, -1); err != note.ErrorOutOfRange {
+		t.Errorf(`TransposeScale() wants Error(%v). but got "%v"`, note.ErrorOutOfRange, err)
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	_, err := GetScale("notfoundian")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf(`errors.Is(%v, ErrNotFound) should be true`, err)
+	}
+
+	var pe *note.ParseError
+	if !errors.As(err, &pe) || pe.Input != "notfoundian" {
+		t.Errorf(`errors.As(%v) should get *note.ParseError`, err)
+	}
+
+	_, err = GetScaleFromRoot("ionian", "X4")
+	if !errors.Is(err, note.ErrNotFound) {
+		t.Errorf(`errors.Is(%v, note.ErrNotFound) should be true`, err)
+	}
+}
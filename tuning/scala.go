@@ -0,0 +1,502 @@
+package tuning
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+// A scale of a Scala `.scl` file. The 1/1 is implicit, and the last pitch is the period i.e. `2/1` for an octave.
+type Scala struct {
+	Description string
+	Pitches     []Pitch
+}
+
+// A pitch of a Scala scale, which is a ratio `3/2` or cents `701.955`
+type Pitch struct {
+	// A ratio if Denominator is not 0
+	Numerator   int64
+	Denominator int64
+	// Cents if it's not a ratio
+	Cents float64
+}
+
+// A keyboard mapping of a Scala `.kbm` file
+type KeyboardMapping struct {
+	// Size of the map which repeats. `0` for the linear mapping, in which each key is the next degree.
+	Size int
+	// The range of note numbers to be retuned
+	First int
+	Last  int
+	// Note number where the first entry of the map is
+	Middle int
+	// Note number which is tuned to `Frequency`
+	Reference int
+	Frequency float64
+	// Degree of the scale which is the period of the map. `0` for the size of the scale.
+	OctaveDegree int
+	// Degrees of the scale on keys from `Middle`. `-1` for a key which isn't mapped `x`.
+	Map []int
+}
+
+// A key of a Scala tuning
+type Key struct {
+	Number    int
+	Degree    int
+	Frequency float64
+}
+
+// A temperament of a Scala scale mapped by a keyboard mapping. `DefaultKeyboardMapping` is used if Mapping is nil.
+type ScalaTuning struct {
+	Scale   *Scala
+	Mapping *KeyboardMapping
+}
+
+// The linear mapping from `C4` (note number 60), with `A4` on 440 Hz
+var DefaultKeyboardMapping = KeyboardMapping{Size: 0, First: 0, Last: 127, Middle: 60, Reference: 69, Frequency: 440}
+
+// Sentinel errors
+var (
+	ErrInvalidScala           = errors.New("Invalid Scala file")
+	ErrInvalidKeyboardMapping = errors.New("Invalid keyboard mapping")
+	ErrNotMapped              = errors.New("Not mapped key")
+)
+
+var (
+	ErrorInvalidScalaPitch = func(p Pitch) error { return fmt.Errorf("%w. %+v", ErrInvalidScala, p) }
+	ErrorNotMapped         = func(noteNumber int) error { return fmt.Errorf("%w. %d", ErrNotMapped, noteNumber) }
+)
+
+// An error on reading a Scala file with the line number
+type FileError struct {
+	// Line number from 1
+	Line int
+	// Why it's invalid `ratio should be positive`
+	Reason string
+	// `ErrInvalidScala` or `ErrInvalidKeyboardMapping`
+	Err error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s. line %d: %s", e.Err.Error(), e.Line, e.Reason)
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// Get a pitch in cents
+func (p Pitch) Value() float64 {
+	if p.Denominator != 0 {
+		return 1200 * math.Log2(float64(p.Numerator)/float64(p.Denominator))
+	}
+
+	return p.Cents
+}
+
+// Get a pitch as a line of `.scl` `3/2` or `701.955`. Cents always have a period.
+func (p Pitch) String() string {
+	if p.Denominator != 0 {
+		return fmt.Sprintf("%d/%d", p.Numerator, p.Denominator)
+	}
+
+	s := strconv.FormatFloat(p.Cents, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// Read a Scala scale from a `.scl` file. Lines starting with `!` are comments, and text after a pitch is ignored.
+func ReadScala(r io.Reader) (*Scala, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scala{}
+	count := -1
+	described := false
+	last := len(lines)
+	for i, line := range lines {
+		if strings.HasPrefix(line, "!") {
+			continue
+		}
+		lineError := func(reason string) error { return &FileError{Line: i + 1, Reason: reason, Err: ErrInvalidScala} }
+		field := strings.TrimSpace(line)
+		switch {
+		case !described:
+			s.Description, described = field, true
+		case count < 0:
+			n, err := strconv.Atoi(firstField(field))
+			if err != nil || n < 0 {
+				return nil, lineError("number of notes should be 0 or more")
+			}
+			count = n
+		case field == "":
+			continue
+		case len(s.Pitches) < count:
+			p, err := parsePitch(firstField(field))
+			if err != nil {
+				return nil, lineError(err.Error())
+			}
+			s.Pitches = append(s.Pitches, p)
+		}
+		if count >= 0 && len(s.Pitches) == count {
+			last = i + 1
+			break
+		}
+	}
+
+	if count < 0 {
+		return nil, &FileError{Line: last, Reason: "no number of notes", Err: ErrInvalidScala}
+	}
+	if len(s.Pitches) < count {
+		return nil, &FileError{Line: last, Reason: fmt.Sprintf("%d notes are expected, but %d", count, len(s.Pitches)), Err: ErrInvalidScala}
+	}
+
+	return s, nil
+}
+
+// Write a scale as a `.scl` file
+func (s *Scala) Write(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("! " + s.Description + "\n!\n")
+	buf.WriteString(s.Description + "\n")
+	buf.WriteString(fmt.Sprintf(" %d\n!\n", len(s.Pitches)))
+	for _, p := range s.Pitches {
+		if p.Denominator != 0 && (p.Numerator <= 0 || p.Denominator < 0) {
+			return ErrorInvalidScalaPitch(p)
+		}
+		buf.WriteString(" " + p.String() + "\n")
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// Get cents of degrees from the 1/1, without the period
+func (s *Scala) Degrees() []float64 {
+	degrees := []float64{0}
+	for i := 0; i < len(s.Pitches)-1; i++ {
+		degrees = append(degrees, s.Pitches[i].Value())
+	}
+
+	return degrees
+}
+
+// Get the nearest semitones of degrees `{0, 2, 4, 5, 7, 9, 11}` like `scale.GetScale()`
+func (s *Scala) Steps() []int {
+	var steps []int
+	for _, c := range s.Degrees() {
+		steps = append(steps, int(math.Round(c/100)))
+	}
+
+	return steps
+}
+
+// Register the nearest semitones of the scale as a scale of `scale` package. It's an error if degrees are not in an octave,
+// or two degrees are on the same semitone.
+func (s *Scala) Register(scaleName string, aliases ...string) error {
+	return scale.RegisterScale(scaleName, s.Steps(), aliases...)
+}
+
+// Get a Scala scale of the temperament on the tonic
+func (t TwelveTone) Scala() *Scala {
+	s := &Scala{Description: t.Name}
+	for _, c := range t.Cents[1:] {
+		s.Pitches = append(s.Pitches, Pitch{Cents: c})
+	}
+	s.Pitches = append(s.Pitches, Pitch{Numerator: 2, Denominator: 1})
+
+	return s
+}
+
+// Read a keyboard mapping from a `.kbm` file. Lines starting with `!` and blank lines are skipped.
+// Missing entries of the map are not mapped.
+func ReadKeyboardMapping(r io.Reader) (*KeyboardMapping, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	km := &KeyboardMapping{}
+	headers := []*int{&km.Size, &km.First, &km.Last, &km.Middle, &km.Reference, nil, &km.OctaveDegree}
+	index := 0
+	for i, line := range lines {
+		field := firstField(strings.TrimSpace(line))
+		if strings.HasPrefix(line, "!") || field == "" {
+			continue
+		}
+		lineError := func(reason string) error {
+			return &FileError{Line: i + 1, Reason: reason, Err: ErrInvalidKeyboardMapping}
+		}
+		switch {
+		case index == 5:
+			f, err := strconv.ParseFloat(field, 64)
+			if err != nil || f <= 0 {
+				return nil, lineError("frequency should be positive")
+			}
+			km.Frequency = f
+		case index < len(headers):
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, lineError("should be an integer")
+			}
+			*headers[index] = n
+			if reason := km.validateHeader(index); reason != "" {
+				return nil, lineError(reason)
+			}
+		case len(km.Map) < km.Size:
+			if field == "x" || field == "X" {
+				km.Map = append(km.Map, -1)
+				break
+			}
+			n, err := strconv.Atoi(field)
+			if err != nil || n < 0 {
+				return nil, lineError("degree should be 0 or more, or `x`")
+			}
+			km.Map = append(km.Map, n)
+		}
+		index++
+	}
+
+	if index < len(headers) {
+		return nil, &FileError{Line: len(lines), Reason: "too few lines", Err: ErrInvalidKeyboardMapping}
+	}
+	for len(km.Map) < km.Size {
+		km.Map = append(km.Map, -1)
+	}
+
+	return km, nil
+}
+
+// Write a keyboard mapping as a `.kbm` file
+func (km *KeyboardMapping) Write(w io.Writer) error {
+	if err := km.validate(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("! Size of map\n%d\n", km.Size))
+	buf.WriteString(fmt.Sprintf("! First MIDI note number to retune\n%d\n", km.First))
+	buf.WriteString(fmt.Sprintf("! Last MIDI note number to retune\n%d\n", km.Last))
+	buf.WriteString(fmt.Sprintf("! Middle note where the first entry of the mapping is mapped to\n%d\n", km.Middle))
+	buf.WriteString(fmt.Sprintf("! Reference note for which frequency is given\n%d\n", km.Reference))
+	buf.WriteString(fmt.Sprintf("! Frequency to tune the above note to\n%s\n", strconv.FormatFloat(km.Frequency, 'f', -1, 64)))
+	buf.WriteString(fmt.Sprintf("! Scale degree to consider as formal octave\n%d\n", km.OctaveDegree))
+	buf.WriteString("! Mapping\n")
+	for i := 0; i < km.Size; i++ {
+		if i >= len(km.Map) || km.Map[i] < 0 {
+			buf.WriteString("x\n")
+		} else {
+			buf.WriteString(fmt.Sprintf("%d\n", km.Map[i]))
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// Get a frequency in Hz of a note number
+func (t ScalaTuning) Frequency(noteNumber int) (float64, error) {
+	km := t.keyboardMapping()
+	if err := t.validate(km); err != nil {
+		return 0, err
+	}
+	if noteNumber < note.MinimumNoteNumber || noteNumber > note.MaximumNoteNumber {
+		return 0, note.ErrorOutOfRange
+	}
+	if noteNumber < km.First || noteNumber > km.Last {
+		return 0, ErrorNotMapped(noteNumber)
+	}
+
+	cents, err := t.cents(noteNumber)
+	if err != nil {
+		return 0, err
+	}
+	reference, err := t.cents(km.Reference)
+	if err != nil {
+		return 0, err
+	}
+
+	return km.Frequency * math.Pow(2, (cents-reference)/1200), nil
+}
+
+// Get the degree of the scale on a note number. It's over the size of the scale above the first period.
+func (t ScalaTuning) Degree(noteNumber int) (int, error) {
+	km := t.keyboardMapping()
+	if err := t.validate(km); err != nil {
+		return 0, err
+	}
+	steps := noteNumber - km.Middle
+	if km.Size == 0 {
+		return steps, nil
+	}
+
+	octaveDegree := km.OctaveDegree
+	if octaveDegree == 0 {
+		octaveDegree = len(t.Scale.Pitches)
+	}
+	degree := km.Map[mod(steps, km.Size)]
+	if degree < 0 {
+		return 0, ErrorNotMapped(noteNumber)
+	}
+
+	return degree + floorDiv(steps, km.Size)*octaveDegree, nil
+}
+
+// Get mapped keys from `First` to `Last` of the keyboard mapping with degrees and frequencies
+func (t ScalaTuning) Keys() ([]Key, error) {
+	km := t.keyboardMapping()
+	if err := t.validate(km); err != nil {
+		return nil, err
+	}
+
+	var keys []Key
+	for n := km.First; n <= km.Last; n++ {
+		degree, err := t.Degree(n)
+		if errors.Is(err, ErrNotMapped) {
+			continue
+		}
+		f, err := t.Frequency(n)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, Key{Number: n, Degree: degree, Frequency: f})
+	}
+
+	return keys, nil
+}
+
+func (t ScalaTuning) keyboardMapping() *KeyboardMapping {
+	if t.Mapping == nil {
+		return &DefaultKeyboardMapping
+	}
+
+	return t.Mapping
+}
+
+// the scale should have pitches, and the keyboard mapping should be valid
+func (t ScalaTuning) validate(km *KeyboardMapping) error {
+	if t.Scale == nil || len(t.Scale.Pitches) == 0 {
+		return fmt.Errorf("%w. no pitches of scale", ErrInvalidScala)
+	}
+
+	return km.validate()
+}
+
+// cents of a note number from the middle note
+func (t ScalaTuning) cents(noteNumber int) (float64, error) {
+	degree, err := t.Degree(noteNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	size := len(t.Scale.Pitches)
+	return float64(floorDiv(degree, size))*t.Scale.Pitches[size-1].Value() + t.Scale.Degrees()[mod(degree, size)], nil
+}
+
+func (km *KeyboardMapping) validate() error {
+	for index := 0; index < 7; index++ {
+		if reason := km.validateHeader(index); reason != "" {
+			return fmt.Errorf("%w. %s", ErrInvalidKeyboardMapping, reason)
+		}
+	}
+	if len(km.Map) < km.Size {
+		return fmt.Errorf("%w. %d entries of map are expected, but %d", ErrInvalidKeyboardMapping, km.Size, len(km.Map))
+	}
+
+	return nil
+}
+
+// validate a header field of an index in a `.kbm` file, and get the reason if it's invalid
+func (km *KeyboardMapping) validateHeader(index int) string {
+	inRange := func(n int) bool { return n >= note.MinimumNoteNumber && n <= note.MaximumNoteNumber }
+	switch {
+	case index == 0 && km.Size < 0:
+		return "size of map should be 0 or more"
+	case index == 1 && !inRange(km.First):
+		return "first note should be 0 to 127"
+	case index == 2 && (!inRange(km.Last) || km.Last < km.First):
+		return "last note should be the first note to 127"
+	case index == 3 && !inRange(km.Middle):
+		return "middle note should be 0 to 127"
+	case index == 4 && !inRange(km.Reference):
+		return "reference note should be 0 to 127"
+	case index == 5 && km.Frequency <= 0:
+		return "frequency should be positive"
+	case index == 6 && km.OctaveDegree < 0:
+		return "octave degree should be 0 or more"
+	}
+
+	return ""
+}
+
+func parsePitch(field string) (Pitch, error) {
+	if strings.Contains(field, ".") {
+		c, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return Pitch{}, errors.New("invalid cents")
+		}
+		return Pitch{Cents: c}, nil
+	}
+
+	numerator, denominator := field, "1"
+	if i := strings.Index(field, "/"); i >= 0 {
+		numerator, denominator = field[:i], field[i+1:]
+	}
+	n, errN := strconv.ParseInt(numerator, 10, 64)
+	d, errD := strconv.ParseInt(denominator, 10, 64)
+	if errN != nil || errD != nil {
+		return Pitch{}, errors.New("invalid ratio")
+	}
+	if n <= 0 || d <= 0 {
+		return Pitch{}, errors.New("ratio should be positive")
+	}
+
+	return Pitch{Numerator: n, Denominator: d}, nil
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+
+	return lines, scanner.Err()
+}
+
+func firstField(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
+
+func floorDiv(a int, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+
+	return q
+}
+
+func mod(a int, b int) int {
+	return ((a % b) + b) % b
+}
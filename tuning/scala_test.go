@@ -0,0 +1,319 @@
+package tuning
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+const meantoneScl = `! meanquar.scl
+!
+1/4-comma meantone scale. Pietro Aaron's temperament (1523)
+ 12
+!
+ 76.04900
+ 193.15686
+ 310.26471
+ 5/4           major third
+ 503.42157
+ 579.47057
+ 696.57843
+ 25/16
+ 889.73529
+ 1006.84314
+ 1082.89214
+ 2
+`
+
+const justMajorScl = `! just major
+Just major
+7
+9/8
+5/4
+4/3
+3/2
+5/3
+15/8
+2/1
+`
+
+const whiteKeysKbm = `! white keys
+12
+0
+127
+60
+69
+440.0
+7
+! mapping
+0
+x
+1
+x
+2
+3
+x
+4
+x
+5
+x
+6
+`
+
+func TestReadScala(t *testing.T) {
+	s, err := ReadScala(strings.NewReader(meantoneScl))
+	if err != nil {
+		t.Fatalf(`ReadScala, %v`, err)
+	}
+
+	if s.Description != "1/4-comma meantone scale. Pietro Aaron's temperament (1523)" {
+		t.Errorf(`Description, actual:%q`, s.Description)
+	}
+	if len(s.Pitches) != 12 {
+		t.Fatalf(`Pitches, actual:%v`, s.Pitches)
+	}
+	if s.Pitches[0] != (Pitch{Cents: 76.049}) || s.Pitches[3] != (Pitch{Numerator: 5, Denominator: 4}) || s.Pitches[11] != (Pitch{Numerator: 2, Denominator: 1}) {
+		t.Errorf(`Pitches, actual:%v`, s.Pitches)
+	}
+	if want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}; !reflect.DeepEqual(s.Steps(), want) {
+		t.Errorf(`Steps, actual:%v, want:%v`, s.Steps(), want)
+	}
+	if math.Abs(s.Degrees()[8]-772.6274) > 0.0001 {
+		t.Errorf(`Degrees, actual:%v`, s.Degrees())
+	}
+}
+
+func TestReadScalaError(t *testing.T) {
+	tests := []struct {
+		scl  string
+		line int
+	}{
+		{scl: "! empty\n", line: 1},
+		{scl: "desc\nabc\n", line: 2},
+		{scl: "desc\n-1\n", line: 2},
+		{scl: "! comment\ndesc\n2\n3/2\n0/1\n", line: 5},
+		{scl: "desc\n2\n3/0\n2/1\n", line: 3},
+		{scl: "desc\n2\n1.2.3\n2/1\n", line: 3},
+		{scl: "desc\n2\nabc\n2/1\n", line: 3},
+		{scl: "desc\n3\n3/2\n\n2/1\n", line: 5},
+	}
+
+	for _, test := range tests {
+		_, err := ReadScala(strings.NewReader(test.scl))
+		var fileError *FileError
+		if !errors.Is(err, ErrInvalidScala) || !errors.As(err, &fileError) || fileError.Line != test.line {
+			t.Errorf(`ReadScala(%q), actual:"%v", want line:%d`, test.scl, err, test.line)
+		}
+	}
+}
+
+func TestScalaWrite(t *testing.T) {
+	s, _ := ReadScala(strings.NewReader(meantoneScl))
+
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		t.Fatalf(`Write, %v`, err)
+	}
+	actual, err := ReadScala(&buf)
+	if err != nil || !reflect.DeepEqual(actual, s) {
+		t.Errorf(`Write and ReadScala, actual:%v, want:%v. %v`, actual, s, err)
+	}
+
+	invalid := &Scala{Pitches: []Pitch{{Numerator: -3, Denominator: 2}}}
+	if err := invalid.Write(&buf); !errors.Is(err, ErrInvalidScala) {
+		t.Errorf(`Write invalid pitch, actual:"%v", want:"%v"`, err, ErrInvalidScala)
+	}
+}
+
+func TestPitchString(t *testing.T) {
+	tests := []struct {
+		pitch Pitch
+		want  string
+	}{
+		{pitch: Pitch{Numerator: 3, Denominator: 2}, want: "3/2"},
+		{pitch: Pitch{Cents: 701.955}, want: "701.955"},
+		{pitch: Pitch{Cents: 1200}, want: "1200.0"},
+		{pitch: Pitch{Cents: -10}, want: "-10.0"},
+	}
+
+	for _, test := range tests {
+		if actual := test.pitch.String(); actual != test.want {
+			t.Errorf(`String(%+v), actual:%q, want:%q`, test.pitch, actual, test.want)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	s, _ := ReadScala(strings.NewReader(justMajorScl))
+	if err := s.Register("scala-just-major"); err != nil {
+		t.Fatalf(`Register, %v`, err)
+	}
+
+	actual, err := scale.GetScale("scala-just-major")
+	if want := []int{0, 2, 4, 5, 7, 9, 11}; err != nil || !reflect.DeepEqual(actual, want) {
+		t.Errorf(`GetScale, actual:%v, want:%v. %v`, actual, want, err)
+	}
+
+	if err := s.Register("scala-just-major"); !errors.Is(err, scale.ErrDuplicateScale) {
+		t.Errorf(`Register again, actual:"%v", want:"%v"`, err, scale.ErrDuplicateScale)
+	}
+}
+
+func TestReadKeyboardMapping(t *testing.T) {
+	km, err := ReadKeyboardMapping(strings.NewReader(whiteKeysKbm))
+	if err != nil {
+		t.Fatalf(`ReadKeyboardMapping, %v`, err)
+	}
+
+	want := &KeyboardMapping{
+		Size: 12, First: 0, Last: 127, Middle: 60, Reference: 69, Frequency: 440, OctaveDegree: 7,
+		Map: []int{0, -1, 1, -1, 2, 3, -1, 4, -1, 5, -1, 6},
+	}
+	if !reflect.DeepEqual(km, want) {
+		t.Errorf(`ReadKeyboardMapping, actual:%+v, want:%+v`, km, want)
+	}
+
+	var buf bytes.Buffer
+	if err := km.Write(&buf); err != nil {
+		t.Fatalf(`Write, %v`, err)
+	}
+	actual, err := ReadKeyboardMapping(&buf)
+	if err != nil || !reflect.DeepEqual(actual, km) {
+		t.Errorf(`Write and ReadKeyboardMapping, actual:%+v, want:%+v. %v`, actual, km, err)
+	}
+
+	// missing entries are not mapped
+	km, err = ReadKeyboardMapping(strings.NewReader("3\n0\n127\n60\n69\n440\n0\n0\n"))
+	if err != nil || !reflect.DeepEqual(km.Map, []int{0, -1, -1}) {
+		t.Errorf(`ReadKeyboardMapping with missing entries, actual:%+v. %v`, km, err)
+	}
+}
+
+func TestReadKeyboardMappingError(t *testing.T) {
+	tests := []struct {
+		kbm  string
+		line int
+	}{
+		{kbm: "-1\n", line: 1},
+		{kbm: "0\n0\n128\n", line: 3},
+		{kbm: "0\n60\n59\n", line: 3},
+		{kbm: "! size\n0\n0\n127\n60\n69\n0\n", line: 7},
+		{kbm: "0\n0\n127\n60\n69\nabc\n", line: 6},
+		{kbm: "1\n0\n127\n60\n69\n440\n12\ny\n", line: 8},
+		{kbm: "0\n0\n127\n60\n69\n440\n", line: 6},
+	}
+
+	for _, test := range tests {
+		_, err := ReadKeyboardMapping(strings.NewReader(test.kbm))
+		var fileError *FileError
+		if !errors.Is(err, ErrInvalidKeyboardMapping) || !errors.As(err, &fileError) || fileError.Line != test.line {
+			t.Errorf(`ReadKeyboardMapping(%q), actual:"%v", want line:%d`, test.kbm, err, test.line)
+		}
+	}
+}
+
+func TestScalaTuningFrequency(t *testing.T) {
+	s, _ := ReadScala(strings.NewReader(justMajorScl))
+	km, _ := ReadKeyboardMapping(strings.NewReader(whiteKeysKbm))
+	chromatic, _ := ReadScala(strings.NewReader(meantoneScl))
+
+	tests := []struct {
+		tuning     ScalaTuning
+		noteNumber int
+		want       float64
+	}{
+		{tuning: ScalaTuning{Scale: s}, noteNumber: 60, want: 176},
+		{tuning: ScalaTuning{Scale: s}, noteNumber: 69, want: 440},
+		{tuning: ScalaTuning{Scale: s, Mapping: km}, noteNumber: 60, want: 264},
+		{tuning: ScalaTuning{Scale: s, Mapping: km}, noteNumber: 67, want: 396},
+		{tuning: ScalaTuning{Scale: s, Mapping: km}, noteNumber: 71, want: 495},
+		{tuning: ScalaTuning{Scale: s, Mapping: km}, noteNumber: 72, want: 528},
+		{tuning: ScalaTuning{Scale: s, Mapping: km}, noteNumber: 48, want: 132},
+		{tuning: ScalaTuning{Scale: chromatic}, noteNumber: 64, want: 440 / math.Pow(2, 889.73529/1200) * 5 / 4},
+	}
+
+	for _, test := range tests {
+		actual, err := test.tuning.Frequency(test.noteNumber)
+		if err != nil || math.Abs(actual-test.want) > 0.0001 {
+			t.Errorf(`Frequency(%d), actual:"%v", want:"%v". %v`, test.noteNumber, actual, test.want, err)
+		}
+	}
+
+	if _, err := (ScalaTuning{Scale: s, Mapping: km}).Frequency(61); !errors.Is(err, ErrNotMapped) {
+		t.Errorf(`Frequency(61), actual:"%v", want:"%v"`, err, ErrNotMapped)
+	}
+	if _, err := (ScalaTuning{Scale: s}).Frequency(128); !errors.Is(err, note.ErrOutOfRange) {
+		t.Errorf(`Frequency(128), actual:"%v", want:"%v"`, err, note.ErrOutOfRange)
+	}
+
+	var zero ScalaTuning
+	if _, err := zero.Frequency(60); !errors.Is(err, ErrInvalidScala) {
+		t.Errorf(`Frequency of zero value, actual:"%v", want:"%v"`, err, ErrInvalidScala)
+	}
+	if _, err := zero.Degree(60); !errors.Is(err, ErrInvalidScala) {
+		t.Errorf(`Degree of zero value, actual:"%v", want:"%v"`, err, ErrInvalidScala)
+	}
+	if _, err := zero.Keys(); !errors.Is(err, ErrInvalidScala) {
+		t.Errorf(`Keys of zero value, actual:"%v", want:"%v"`, err, ErrInvalidScala)
+	}
+}
+
+func TestScalaTuningKeys(t *testing.T) {
+	s, _ := ReadScala(strings.NewReader(justMajorScl))
+	km, _ := ReadKeyboardMapping(strings.NewReader(whiteKeysKbm))
+	km.First, km.Last = 60, 72
+
+	keys, err := ScalaTuning{Scale: s, Mapping: km}.Keys()
+	if err != nil {
+		t.Fatalf(`Keys, %v`, err)
+	}
+
+	want := []Key{
+		{Number: 60, Degree: 0, Frequency: 264},
+		{Number: 62, Degree: 1, Frequency: 297},
+		{Number: 64, Degree: 2, Frequency: 330},
+		{Number: 65, Degree: 3, Frequency: 352},
+		{Number: 67, Degree: 4, Frequency: 396},
+		{Number: 69, Degree: 5, Frequency: 440},
+		{Number: 71, Degree: 6, Frequency: 495},
+		{Number: 72, Degree: 7, Frequency: 528},
+	}
+	if len(keys) != len(want) {
+		t.Fatalf(`Keys, actual:%v, want:%v`, keys, want)
+	}
+	for i := range keys {
+		if keys[i].Number != want[i].Number || keys[i].Degree != want[i].Degree || math.Abs(keys[i].Frequency-want[i].Frequency) > 0.0001 {
+			t.Errorf(`Keys, actual:%v, want:%v`, keys, want)
+			break
+		}
+	}
+}
+
+func TestTwelveToneScala(t *testing.T) {
+	vallotti, _ := Vallotti("C")
+
+	var buf bytes.Buffer
+	if err := vallotti.Scala().Write(&buf); err != nil {
+		t.Fatalf(`Write, %v`, err)
+	}
+	s, err := ReadScala(&buf)
+	if err != nil || s.Description != "vallotti" || len(s.Pitches) != 12 {
+		t.Fatalf(`ReadScala, actual:%+v. %v`, s, err)
+	}
+
+	// C4 is on the reference of 12 tone equal temperament
+	tuning := ScalaTuning{Scale: s, Mapping: &KeyboardMapping{First: 0, Last: 127, Middle: 60, Reference: 60, Frequency: 261.6255653}}
+	for _, n := range []int{48, 60, 65, 70, 83} {
+		want, _ := vallotti.Frequency(n)
+		actual, err := tuning.Frequency(n)
+		if err != nil || math.Abs(actual-want) > 0.0001 {
+			t.Errorf(`Frequency(%d), actual:"%v", want:"%v". %v`, n, actual, want, err)
+		}
+	}
+}
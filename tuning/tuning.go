@@ -0,0 +1,197 @@
+package tuning
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+// A temperament gives a frequency to a note number
+type Temperament interface {
+	// Get a frequency in Hz of a note number
+	Frequency(noteNumber int) (float64, error)
+}
+
+// Sentinel errors
+var ErrInvalidDivisions = errors.New("Divisions of an octave should be 1 or more")
+
+var ErrorInvalidDivisions = func(divisions int) error { return fmt.Errorf("%w. `%d`", ErrInvalidDivisions, divisions) }
+
+// Equal division of an octave. Each note number is a step, and `A4` (note number 69) is on the reference.
+type EDO struct {
+	Divisions int
+	Reference note.Reference
+}
+
+// 12 tone equal temperament on `A440`
+var Equal = EDO{Divisions: 12, Reference: note.A440}
+
+// Make an equal division of an octave into `divisions` steps `31` on `A440`
+func NewEDO(divisions int) (EDO, error) {
+	if divisions < 1 {
+		return EDO{}, ErrorInvalidDivisions(divisions)
+	}
+
+	return EDO{Divisions: divisions, Reference: note.A440}, nil
+}
+
+// Get a frequency in Hz of a note number as a step from `A4`
+func (e EDO) Frequency(noteNumber int) (float64, error) {
+	if e.Divisions < 1 {
+		return 0, ErrorInvalidDivisions(e.Divisions)
+	}
+	if err := validate(e.Reference, noteNumber); err != nil {
+		return 0, err
+	}
+
+	return e.Reference.A4 * math.Pow(2, float64(noteNumber-69)/float64(e.Divisions)+e.Reference.Cents/1200), nil
+}
+
+// A temperament of 12 notes in an octave, which is given as cents of each note above the tonic
+type TwelveTone struct {
+	Name string
+	// Cents of each pitch class above the tonic. The first one is 0.
+	Cents [12]float64
+	// Pitch class of the tonic
+	Tonic int
+	// The tonic is tuned as 12 tone equal temperament on the reference
+	Reference note.Reference
+}
+
+// Get a frequency in Hz of a note number
+func (t TwelveTone) Frequency(noteNumber int) (float64, error) {
+	if err := validate(t.Reference, noteNumber); err != nil {
+		return 0, err
+	}
+
+	degree := ((noteNumber-t.Tonic)%12 + 12) % 12
+	tonic := noteNumber - degree
+
+	return t.Reference.A4 * math.Pow(2, (float64(tonic-69)*100+t.Reference.Cents+t.Cents[degree])/1200), nil
+}
+
+// 5-limit just intonation on a tonic `C`
+func JustIntonation(tonic string) (TwelveTone, error) {
+	return fromRatios("just", tonic, [12][2]float64{
+		{1, 1}, {16, 15}, {9, 8}, {6, 5}, {5, 4}, {4, 3}, {45, 32}, {3, 2}, {8, 5}, {5, 3}, {9, 5}, {15, 8},
+	})
+}
+
+// Pythagorean tuning on a tonic `C`, with pure fifths from `b5` to `#4`
+func Pythagorean(tonic string) (TwelveTone, error) {
+	return fromRatios("pythagorean", tonic, [12][2]float64{
+		{1, 1}, {256, 243}, {9, 8}, {32, 27}, {81, 64}, {4, 3}, {729, 512}, {3, 2}, {128, 81}, {27, 16}, {16, 9}, {243, 128},
+	})
+}
+
+// Quarter-comma meantone on a tonic `C`, with tempered fifths from `b3` to `#5`
+func QuarterCommaMeantone(tonic string) (TwelveTone, error) {
+	fifth := 1200*math.Log2(1.5) - 1200*math.Log2(81.0/80)/4
+	var cents [12]float64
+	for k := -3; k <= 8; k++ {
+		c := math.Mod(float64(k)*fifth, 1200)
+		if c < 0 {
+			c += 1200
+		}
+		cents[((k*7)%12+12)%12] = c
+	}
+
+	return newTwelveTone("meantone", tonic, cents)
+}
+
+// Werckmeister III on a tonic `C`
+func WerckmeisterIII(tonic string) (TwelveTone, error) {
+	return newTwelveTone("werckmeister3", tonic, [12]float64{
+		0, 90.225, 192.180, 294.135, 390.225, 498.045, 588.270, 696.090, 792.180, 888.270, 996.090, 1092.180,
+	})
+}
+
+// Vallotti on a tonic `C`
+func Vallotti(tonic string) (TwelveTone, error) {
+	return newTwelveTone("vallotti", tonic, [12]float64{
+		0, 94.135, 196.090, 298.045, 392.180, 501.955, 592.180, 698.045, 796.090, 894.135, 1000, 1090.225,
+	})
+}
+
+// Get a frequency in Hz of a note with octave number on a temperament
+func FrequencyOf(t Temperament, n note.Note) (float64, error) {
+	noteNumber, err := n.MIDI()
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Frequency(noteNumber)
+}
+
+// Get cents deviations from 12 tone equal temperament of each degree of a scale `ionian` on a tonic `C4` with a temperament.
+// Each degree is measured from the tonic. The tonic is on the octave 4 without an octave number.
+// On `EDO`, each degree is the nearest step, i.e. the major 3rd is 10 steps on 31 EDO.
+func Deviations(t Temperament, scaleName string, tonic string) ([]float64, error) {
+	sc, err := scale.GetScale(scaleName)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := note.Parse(tonic)
+	if err != nil {
+		return nil, err
+	}
+	if !n.HasOctave {
+		n.Octave, n.HasOctave = 4, true
+	}
+	tonicNumber, err := n.MIDI()
+	if err != nil {
+		return nil, err
+	}
+	tonicFrequency, err := t.Frequency(tonicNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var deviations []float64
+	for _, degree := range sc {
+		step := degree
+		if e, ok := t.(EDO); ok {
+			step = int(math.Round(float64(degree*e.Divisions) / 12))
+		}
+		f, err := t.Frequency(tonicNumber + step)
+		if err != nil {
+			return nil, err
+		}
+		deviations = append(deviations, 1200*math.Log2(f/tonicFrequency)-float64(degree*100))
+	}
+
+	return deviations, nil
+}
+
+func fromRatios(name string, tonic string, ratios [12][2]float64) (TwelveTone, error) {
+	var cents [12]float64
+	for i, r := range ratios {
+		cents[i] = 1200 * math.Log2(r[0]/r[1])
+	}
+
+	return newTwelveTone(name, tonic, cents)
+}
+
+func newTwelveTone(name string, tonic string, cents [12]float64) (TwelveTone, error) {
+	n, err := note.Parse(tonic)
+	if err != nil {
+		return TwelveTone{}, err
+	}
+
+	return TwelveTone{Name: name, Cents: cents, Tonic: n.PitchClass(), Reference: note.A440}, nil
+}
+
+func validate(r note.Reference, noteNumber int) error {
+	if r.A4 <= 0 {
+		return note.ErrorInvalidFrequency(r.A4)
+	}
+	if noteNumber < note.MinimumNoteNumber || noteNumber > note.MaximumNoteNumber {
+		return note.ErrorOutOfRange
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package tuning
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/note"
+	"github.com/bayashi/go-music-chord-note/scale"
+)
+
+func TestFrequency(t *testing.T) {
+	edo24, _ := NewEDO(24)
+	justC, _ := JustIntonation("C")
+	justA, _ := JustIntonation("A")
+	pythagoreanD, _ := Pythagorean("D")
+	meantoneC, _ := QuarterCommaMeantone("C")
+	vallottiC, _ := Vallotti("C")
+
+	tests := []struct {
+		temperament Temperament
+		noteNumber  int
+		want        float64
+	}{
+		{temperament: Equal, noteNumber: 69, want: 440},
+		{temperament: Equal, noteNumber: 60, want: 261.6256},
+		{temperament: edo24, noteNumber: 70, want: 452.8930},
+		{temperament: edo24, noteNumber: 93, want: 880},
+		{temperament: justC, noteNumber: 60, want: 261.6256},
+		{temperament: justC, noteNumber: 67, want: 392.4383},
+		{temperament: justC, noteNumber: 69, want: 436.0426},
+		{temperament: justC, noteNumber: 72, want: 523.2511},
+		{temperament: justA, noteNumber: 69, want: 440},
+		{temperament: justA, noteNumber: 73, want: 550},
+		{temperament: justA, noteNumber: 76, want: 660},
+		{temperament: pythagoreanD, noteNumber: 69, want: 440.4972},
+		{temperament: meantoneC, noteNumber: 64, want: 327.0319},
+		{temperament: vallottiC, noteNumber: 70, want: 466.1638},
+	}
+
+	for _, test := range tests {
+		actual, err := test.temperament.Frequency(test.noteNumber)
+		if err != nil || math.Abs(actual-test.want) > 0.0001 {
+			t.Errorf(`Frequency(%v) on %+v, actual:"%v", want:"%v". %v`, test.noteNumber, test.temperament, actual, test.want, err)
+		}
+	}
+}
+
+func TestFrequencyError(t *testing.T) {
+	justC, _ := JustIntonation("C")
+
+	tests := []struct {
+		temperament Temperament
+		noteNumber  int
+		want        error
+	}{
+		{temperament: Equal, noteNumber: 128, want: note.ErrOutOfRange},
+		{temperament: justC, noteNumber: -1, want: note.ErrOutOfRange},
+		{temperament: EDO{Divisions: 0, Reference: note.A440}, noteNumber: 60, want: ErrInvalidDivisions},
+		{temperament: EDO{Divisions: 12}, noteNumber: 60, want: note.ErrInvalidFrequency},
+	}
+
+	for _, test := range tests {
+		_, err := test.temperament.Frequency(test.noteNumber)
+		if !errors.Is(err, test.want) {
+			t.Errorf(`Frequency(%v) on %+v, actual:"%v", want:"%v"`, test.noteNumber, test.temperament, err, test.want)
+		}
+	}
+}
+
+func TestNewEDO(t *testing.T) {
+	if _, err := NewEDO(0); !errors.Is(err, ErrInvalidDivisions) {
+		t.Errorf(`NewEDO(0), actual:"%v", want:"%v"`, err, ErrInvalidDivisions)
+	}
+	if _, err := JustIntonation("X"); err == nil {
+		t.Errorf(`JustIntonation("X") should be an error`)
+	}
+}
+
+func TestFrequencyOf(t *testing.T) {
+	justA, _ := JustIntonation("A")
+	n, _ := note.Parse("E5")
+	actual, err := FrequencyOf(justA, n)
+	if err != nil || math.Abs(actual-660) > 0.0001 {
+		t.Errorf(`FrequencyOf("E5"), actual:"%v", want:"660". %v`, actual, err)
+	}
+
+	n, _ = note.Parse("E")
+	if _, err := FrequencyOf(justA, n); err == nil {
+		t.Errorf(`FrequencyOf("E") should be an error without octave`)
+	}
+}
+
+func TestDeviations(t *testing.T) {
+	justC, _ := JustIntonation("C")
+	meantoneC, _ := QuarterCommaMeantone("C")
+	werckmeisterC, _ := WerckmeisterIII("C")
+	pythagoreanG, _ := Pythagorean("G")
+	edo31, _ := NewEDO(31)
+	edo19, _ := NewEDO(19)
+
+	tests := []struct {
+		temperament Temperament
+		scaleName   string
+		tonic       string
+		want        []float64
+	}{
+		{temperament: Equal, scaleName: "ionian", tonic: "C", want: []float64{0, 0, 0, 0, 0, 0, 0}},
+		{temperament: justC, scaleName: "ionian", tonic: "C", want: []float64{0, 3.910, -13.686, -1.955, 1.955, -15.641, -11.731}},
+		{temperament: justC, scaleName: "ionian", tonic: "C2", want: []float64{0, 3.910, -13.686, -1.955, 1.955, -15.641, -11.731}},
+		{temperament: meantoneC, scaleName: "ionian", tonic: "C", want: []float64{0, -6.843, -13.686, 3.422, -3.422, -10.265, -17.108}},
+		{temperament: werckmeisterC, scaleName: "ionian", tonic: "C", want: []float64{0, -7.820, -9.775, -1.955, -3.910, -11.730, -7.820}},
+		// D dorian on G pythagorean is measured from D
+		{temperament: pythagoreanG, scaleName: "dorian", tonic: "D", want: []float64{0, 3.910, -5.865, -1.955, 1.955, 5.865, -3.910}},
+		// the nearest steps on EDO `{0, 5, 10, 13, 18, 23, 28}` of 31
+		{temperament: edo31, scaleName: "ionian", tonic: "C", want: []float64{0, -6.452, -12.903, 3.226, -3.226, -9.677, -16.129}},
+		{temperament: edo19, scaleName: "ionian", tonic: "C", want: []float64{0, -10.526, -21.053, 5.263, -5.263, -15.789, -26.316}},
+	}
+
+	for _, test := range tests {
+		actual, err := Deviations(test.temperament, test.scaleName, test.tonic)
+		if err != nil || len(actual) != len(test.want) {
+			t.Errorf(`Deviations(%q, %q), actual:"%v", want:"%v". %v`, test.scaleName, test.tonic, actual, test.want, err)
+			continue
+		}
+		for i := range actual {
+			if math.Abs(actual[i]-test.want[i]) > 0.001 {
+				t.Errorf(`Deviations(%q, %q), actual:"%v", want:"%v"`, test.scaleName, test.tonic, actual, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestDeviationsError(t *testing.T) {
+	if _, err := Deviations(Equal, "unknown", "C"); !errors.Is(err, scale.ErrNotFound) {
+		t.Errorf(`Deviations("unknown"), actual:"%v", want:"%v"`, err, scale.ErrNotFound)
+	}
+	if _, err := Deviations(Equal, "ionian", "X"); err == nil {
+		t.Errorf(`Deviations with tonic "X" should be an error`)
+	}
+	if _, err := Deviations(Equal, "ionian", "C9"); !errors.Is(err, note.ErrOutOfRange) {
+		t.Errorf(`Deviations with tonic "C9", actual:"%v", want:"%v"`, err, note.ErrOutOfRange)
+	}
+}
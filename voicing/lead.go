@@ -0,0 +1,214 @@
+package voicing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Options of voice leading
+type LeadOptions struct {
+	// Style of every voicing. Close, drop and its inversions are tried. `Close` by default.
+	Style Style
+	// Register range of every voicing. `DefaultRange` by default.
+	Range Range
+	// Avoid parallel fifths and octaves between voicings which have the same number of voices.
+	// Parallels are allowed when there is no other way.
+	AvoidParallels bool
+}
+
+var ErrInvalidVoicing = errors.New("Invalid voicing")
+
+var ErrorInvalidVoicing = func(voicing []int) error { return fmt.Errorf("%w. %v", ErrInvalidVoicing, voicing) }
+
+// Get voicings of chord names `{"Dm7", "G7", "CM7"}` which move from the voicing `start` of the first chord as smooth as possible.
+// Each next voicing is the one with the least total semitone movement, then with the most common tones.
+// If `start` is nil, the first voicing is the one by `VoiceChord()`.
+func Lead(chordNames []string, start []int, opts LeadOptions) ([][]int, error) {
+	if opts.Style == "" {
+		opts.Style = Close
+	}
+	if opts.Range == (Range{}) {
+		opts.Range = DefaultRange
+	}
+	if err := validateRange(opts.Range); err != nil {
+		return nil, err
+	}
+
+	var voicings [][]int
+	for i, chordName := range chordNames {
+		c, err := chord.Parse(chordName)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			first, err := firstVoicing(c, start, opts)
+			if err != nil {
+				return nil, err
+			}
+			voicings = append(voicings, first)
+			continue
+		}
+
+		next, err := nextVoicing(c, voicings[i-1], opts)
+		if err != nil {
+			return nil, err
+		}
+		voicings = append(voicings, next)
+	}
+
+	return voicings, nil
+}
+
+func firstVoicing(c chord.Chord, start []int, opts LeadOptions) ([]int, error) {
+	if start == nil {
+		return VoiceChord(c, opts.Style, opts.Range)
+	}
+
+	if len(start) == 0 {
+		return nil, ErrorInvalidVoicing(start)
+	}
+	voicing := append([]int{}, start...)
+	sort.Ints(voicing)
+	if voicing[0] < note.MinimumNoteNumber || voicing[len(voicing)-1] > note.MaximumNoteNumber {
+		return nil, ErrorInvalidVoicing(start)
+	}
+
+	return voicing, nil
+}
+
+// pick the voicing of a chord which is the closest to the previous one
+func nextVoicing(c chord.Chord, prev []int, opts LeadOptions) ([]int, error) {
+	candidates, err := candidates(c, opts.Style, opts.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	var best, bestWithParallels []int
+	for _, candidate := range candidates {
+		if closer(prev, candidate, bestWithParallels) {
+			bestWithParallels = candidate
+		}
+		if opts.AvoidParallels && hasParallels(prev, candidate) {
+			continue
+		}
+		if closer(prev, candidate, best) {
+			best = candidate
+		}
+	}
+
+	if best == nil {
+		return bestWithParallels, nil
+	}
+
+	return best, nil
+}
+
+// all voicings of a chord in the range including inversions of close and drop voicings
+func candidates(c chord.Chord, style Style, r Range) ([][]int, error) {
+	inversions := 1
+	switch style {
+	case Close, Drop2, Drop3, Drop24:
+		inversions = len(pitchClasses(c))
+	}
+
+	var all [][]int
+	for i := 0; i < inversions; i++ {
+		shape, err := relative(c, style, i)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, positionsOf(shape, r)...)
+	}
+	if len(all) == 0 {
+		return nil, ErrorOutOfRegister(c.Symbol, r)
+	}
+
+	return all, nil
+}
+
+// whether `a` is closer to `prev` than `b`. nil `b` is the farthest.
+func closer(prev []int, a []int, b []int) bool {
+	if b == nil {
+		return true
+	}
+
+	if da, db := movement(prev, a), movement(prev, b); da != db {
+		return da < db
+	}
+
+	if ca, cb := commonTones(prev, a), commonTones(prev, b); ca != cb {
+		return ca > cb
+	}
+
+	return a[0] < b[0]
+}
+
+// total semitones of movement. Voices move one to one if the numbers of voices are same,
+// otherwise each note moves from the nearest note of the previous voicing.
+func movement(from []int, to []int) int {
+	total := 0
+	if len(from) == len(to) {
+		for i := range from {
+			total += abs(to[i] - from[i])
+		}
+		return total
+	}
+
+	for _, n := range to {
+		nearest := abs(n - from[0])
+		for _, f := range from[1:] {
+			if d := abs(n - f); d < nearest {
+				nearest = d
+			}
+		}
+		total += nearest
+	}
+
+	return total
+}
+
+// number of the same note numbers in both voicings
+func commonTones(a []int, b []int) int {
+	count := 0
+	for _, n := range b {
+		if indexOf(a, n) >= 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// whether any two voices move in parallel fifths or octaves. It's checked only when the numbers of voices are same.
+func hasParallels(from []int, to []int) bool {
+	if len(from) != len(to) {
+		return false
+	}
+
+	for i := 0; i < len(from); i++ {
+		for j := i + 1; j < len(from); j++ {
+			if from[i] == to[i] || from[j] == to[j] {
+				continue
+			}
+			before, after := note.PitchClassOf(from[j]-from[i]), note.PitchClassOf(to[j]-to[i])
+			if before == after && (before == 7 || before == 0) && from[j] != from[i] && to[j] != to[i] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,114 @@
+package voicing
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+)
+
+func TestLead(t *testing.T) {
+	tests := []struct {
+		title      string
+		chordNames []string
+		start      []int
+		opts       LeadOptions
+		want       [][]int
+	}{
+		{
+			title:      "ii-V-I",
+			chordNames: []string{"Dm7", "G7", "CM7"},
+			opts:       LeadOptions{Style: Close, Range: DefaultRange},
+			want:       [][]int{{50, 53, 57, 60}, {50, 53, 55, 59}, {48, 52, 55, 59}},
+		},
+		{
+			title:      "I-IV-V-I from the start voicing",
+			chordNames: []string{"C", "F", "G", "C"},
+			start:      []int{67, 60, 64},
+			opts:       LeadOptions{Style: Close, Range: DefaultRange},
+			want:       [][]int{{60, 64, 67}, {60, 65, 69}, {59, 62, 67}, {60, 64, 67}},
+		},
+		{
+			title:      "parallel fifths",
+			chordNames: []string{"C", "D"},
+			start:      []int{48, 52, 55},
+			opts:       LeadOptions{Style: Close, Range: DefaultRange},
+			want:       [][]int{{48, 52, 55}, {50, 54, 57}},
+		},
+		{
+			title:      "avoid parallel fifths",
+			chordNames: []string{"C", "D"},
+			start:      []int{48, 52, 55},
+			opts:       LeadOptions{Style: Close, Range: DefaultRange, AvoidParallels: true},
+			want:       [][]int{{48, 52, 55}, {54, 57, 62}},
+		},
+		{
+			title:      "defaults",
+			chordNames: []string{"Dm7", "G7", "CM7"},
+			opts:       LeadOptions{},
+			want:       [][]int{{50, 53, 57, 60}, {50, 53, 55, 59}, {48, 52, 55, 59}},
+		},
+		{
+			title:      "in range",
+			chordNames: []string{"C", "F"},
+			start:      []int{48, 52, 55},
+			opts:       LeadOptions{Style: Close, Range: Range{Low: 48, High: 59}},
+			want:       [][]int{{48, 52, 55}, {48, 53, 57}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			actual, err := Lead(test.chordNames, test.start, test.opts)
+			if err != nil {
+				t.Fatalf(`Lead(%v) got error: %v`, test.chordNames, err)
+			}
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Lead(%v), actual:"%v", want:"%v"`, test.chordNames, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestLeadError(t *testing.T) {
+	opts := LeadOptions{Style: Close, Range: DefaultRange}
+
+	if _, err := Lead([]string{"C", "G"}, []int{}, opts); !errors.Is(err, ErrInvalidVoicing) {
+		t.Errorf(`Lead() with empty start wants Error(%v). but got "%v"`, ErrInvalidVoicing, err)
+	}
+
+	if _, err := Lead([]string{"C", "G"}, []int{60, 128}, opts); !errors.Is(err, ErrInvalidVoicing) {
+		t.Errorf(`Lead() with start over 127 wants Error(%v). but got "%v"`, ErrInvalidVoicing, err)
+	}
+
+	if _, err := Lead([]string{"C", "GN7"}, nil, opts); !errors.Is(err, chord.ErrNotFoundKind) {
+		t.Errorf(`Lead() with "GN7" wants Error(%v). but got "%v"`, chord.ErrNotFoundKind, err)
+	}
+
+	opts.Range = Range{Low: 60, High: 62}
+	if _, err := Lead([]string{"C", "G"}, []int{60, 64, 67}, opts); !errors.Is(err, ErrOutOfRegister) {
+		t.Errorf(`Lead() in 60-62 wants Error(%v). but got "%v"`, ErrOutOfRegister, err)
+	}
+}
+
+func TestHasParallels(t *testing.T) {
+	tests := []struct {
+		from []int
+		to   []int
+		want bool
+	}{
+		{from: []int{48, 55}, to: []int{50, 57}, want: true},
+		{from: []int{48, 60}, to: []int{50, 62}, want: true},
+		{from: []int{48, 55}, to: []int{48, 55}, want: false},
+		{from: []int{48, 55}, to: []int{48, 67}, want: false},
+		{from: []int{48, 55}, to: []int{50, 58}, want: false},
+		{from: []int{48, 55, 64}, to: []int{50, 57}, want: false},
+	}
+
+	for _, test := range tests {
+		if actual := hasParallels(test.from, test.to); actual != test.want {
+			t.Errorf(`hasParallels(%v, %v), actual:"%v", want:"%v"`, test.from, test.to, actual, test.want)
+		}
+	}
+}
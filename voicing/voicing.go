@@ -0,0 +1,268 @@
+package voicing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+// Style of voicing
+type Style string
+
+const (
+	// All chord tones stacked within an octave from the root
+	Close Style = "close"
+	// Close position with the 2nd voice from the top dropped an octave
+	Drop2 Style = "drop2"
+	// Close position with the 3rd voice from the top dropped an octave
+	Drop3 Style = "drop3"
+	// Close position with the 2nd and the 4th voices from the top dropped an octave
+	Drop24 Style = "drop2and4"
+	// The root and the 5th at the bottom, and other tones over an octave above the root
+	Spread Style = "spread"
+	// The root, the 3rd and the 7th
+	Shell Style = "shell"
+	// The 3rd, the 5th, the 7th and the 9th without the root
+	RootlessA Style = "rootless-a"
+	// The 7th, the 9th, the 3rd and the 5th without the root
+	RootlessB Style = "rootless-b"
+)
+
+// All styles of voicing
+var AllStyles = []Style{Close, Drop2, Drop3, Drop24, Spread, Shell, RootlessA, RootlessB}
+
+// Register range of a voicing in note number. Both ends are included.
+type Range struct {
+	Low  int
+	High int
+}
+
+// C3 to C6
+var DefaultRange = Range{Low: 48, High: 84}
+
+// Sentinel errors
+var (
+	ErrCouldNotVoice = errors.New("Could not voice chord")
+	ErrOutOfRegister = errors.New("Voicing is out of register")
+	ErrInvalidRange  = errors.New("Invalid register range")
+	ErrInvalidStyle  = errors.New("Invalid style of voicing")
+)
+
+var (
+	ErrorCouldNotVoice = func(chordName string, style Style) error {
+		return fmt.Errorf("%w. `%s`, %s", ErrCouldNotVoice, chordName, style)
+	}
+	ErrorOutOfRegister = func(chordName string, r Range) error {
+		return fmt.Errorf("%w. `%s`, %d-%d", ErrOutOfRegister, chordName, r.Low, r.High)
+	}
+	ErrorInvalidRange = func(r Range) error { return fmt.Errorf("%w. %d-%d", ErrInvalidRange, r.Low, r.High) }
+	ErrorInvalidStyle = func(style Style) error { return fmt.Errorf("%w. `%s`", ErrInvalidStyle, style) }
+)
+
+// Get a voicing as note numbers `{48, 55, 64, 71}` from full chord name `CM7`, style `spread` and register range.
+// The voicing is put on the lowest position in the range.
+func Voice(chordName string, style Style, r Range) ([]int, error) {
+	c, err := chord.Parse(chordName)
+	if err != nil {
+		return nil, err
+	}
+
+	return VoiceChord(c, style, r)
+}
+
+// Get a voicing as note numbers of `chord.Chord`. See `Voice()`.
+func VoiceChord(c chord.Chord, style Style, r Range) ([]int, error) {
+	if err := validateRange(r); err != nil {
+		return nil, err
+	}
+
+	shape, err := relative(c, style, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	voicing := place(shape, r.Low)
+	if voicing[len(voicing)-1] > r.High {
+		return nil, ErrorOutOfRegister(c.Symbol, r)
+	}
+
+	return voicing, nil
+}
+
+// Get all voicings of a chord in the range. It's used to find a voicing close to another one.
+func Positions(c chord.Chord, style Style, r Range) ([][]int, error) {
+	if err := validateRange(r); err != nil {
+		return nil, err
+	}
+
+	shape, err := relative(c, style, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := positionsOf(shape, r)
+	if len(positions) == 0 {
+		return nil, ErrorOutOfRegister(c.Symbol, r)
+	}
+
+	return positions, nil
+}
+
+// every octave placement of a shape in the range
+func positionsOf(shape []int, r Range) [][]int {
+	var positions [][]int
+	for voicing := place(shape, r.Low); voicing[len(voicing)-1] <= r.High; voicing = shift(voicing, 12) {
+		positions = append(positions, voicing)
+	}
+
+	return positions
+}
+
+// build a voicing shape as ascending note numbers on the lowest octave. It can start below 0.
+// `inversion` is the index of the chord tone at the bottom of close and drop voicings, and the bass of a slash chord wins over it.
+func relative(c chord.Chord, style Style, inversion int) ([]int, error) {
+	tones := pitchClasses(c)
+	bass := bassPitchClass(c)
+
+	var shape []int
+	switch style {
+	case Close, Drop2, Drop3, Drop24:
+		i := inversion % len(tones)
+		if b := indexOf(tones, bass); b >= 0 {
+			i = b
+		}
+		tones = append(append([]int{}, tones[i:]...), tones[:i]...)
+		shape = stack(tones, tones[0])
+		if (style == Drop2 && len(shape) < 3) || ((style == Drop3 || style == Drop24) && len(shape) < 4) {
+			return nil, ErrorCouldNotVoice(c.Symbol, style)
+		}
+		// count voices from the top
+		for _, d := range map[Style][]int{Drop2: {2}, Drop3: {3}, Drop24: {2, 4}}[style] {
+			shape[len(shape)-d] -= 12
+		}
+		sort.Ints(shape)
+	case Spread:
+		lower := []int{0}
+		rest := tones[1:]
+		if i := indexOf(rest, 7); i >= 0 {
+			lower = append(lower, 7)
+			rest = append(append([]int{}, rest[:i]...), rest[i+1:]...)
+		}
+		shape = lower
+		if len(rest) > 0 {
+			shape = append(shape, stack(rest, 12+rest[0])...)
+		}
+	case Shell, RootlessA, RootlessB:
+		third, seventh := find(tones, 4, 3, 5, 2), find(tones, 10, 11, 9)
+		if third < 0 || seventh < 0 {
+			return nil, ErrorCouldNotVoice(c.Symbol, style)
+		}
+		fifth, ninth := find(tones, 7, 6, 8), find(tones, 2, 1, 3)
+		if fifth < 0 {
+			fifth = 7
+		}
+		if ninth < 0 || ninth == third {
+			ninth = 2
+		}
+		order := map[Style][]int{
+			Shell:     {0, third, seventh},
+			RootlessA: {third, fifth, seventh, ninth},
+			RootlessB: {seventh, ninth, third, fifth},
+		}[style]
+		shape = stack(order, order[0])
+	default:
+		return nil, ErrorInvalidStyle(style)
+	}
+
+	// a bass which is not at the bottom goes just below the voicing
+	if bass >= 0 && note.PitchClassOf(shape[0]) != bass {
+		shape = append([]int{shape[0] - note.PitchClassOf(shape[0]-bass)}, shape...)
+	}
+
+	return shift(shape, c.RootPitchClass), nil
+}
+
+// pitch classes of chord tones relative to the root, in ascending order from the root. `C9` -> `{0, 2, 4, 7, 10}`
+func pitchClasses(c chord.Chord) []int {
+	seen := map[int]bool{}
+	var tones []int
+	for _, n := range c.Intervals {
+		if !seen[n%12] {
+			seen[n%12] = true
+			tones = append(tones, n%12)
+		}
+	}
+	sort.Ints(tones)
+
+	return tones
+}
+
+// pitch class of the bass relative to the root. It's -1 if it's not a slash chord.
+func bassPitchClass(c chord.Chord) int {
+	if c.Bass == "" {
+		return -1
+	}
+	b, _ := note.NoteNumber(c.Bass)
+
+	return note.PitchClassOf(b - c.RootPitchClass)
+}
+
+// stack pitch classes upward from the `start` note, which is for the first pitch class.
+func stack(tones []int, start int) []int {
+	shape := []int{start}
+	for _, pc := range tones[1:] {
+		last := shape[len(shape)-1]
+		shape = append(shape, last+note.PitchClassOf(pc-last-1)+1)
+	}
+
+	return shape
+}
+
+// put a shape on the lowest position where every note is `low` or higher.
+func place(shape []int, low int) []int {
+	offset := low - shape[0]
+	offset += note.PitchClassOf(-offset)
+
+	return shift(shape, offset)
+}
+
+func shift(notes []int, semitones int) []int {
+	var shifted []int
+	for _, n := range notes {
+		shifted = append(shifted, n+semitones)
+	}
+
+	return shifted
+}
+
+// find the first pitch class of candidates in tones. -1 if none.
+func find(tones []int, candidates ...int) int {
+	for _, c := range candidates {
+		if indexOf(tones, c) >= 0 {
+			return c
+		}
+	}
+
+	return -1
+}
+
+func indexOf(list []int, v int) int {
+	for i, n := range list {
+		if n == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func validateRange(r Range) error {
+	if r.Low < note.MinimumNoteNumber || r.High > note.MaximumNoteNumber || r.Low > r.High {
+		return ErrorInvalidRange(r)
+	}
+
+	return nil
+}
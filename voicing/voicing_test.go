@@ -0,0 +1,105 @@
+package voicing
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bayashi/go-music-chord-note/chord"
+	"github.com/bayashi/go-music-chord-note/note"
+)
+
+func TestVoice(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  []int
+	}{
+		{name: "CM7", style: Close, want: []int{48, 52, 55, 59}},
+		{name: "CM7", style: Drop2, want: []int{43, 48, 52, 59}},
+		{name: "CM7", style: Drop3, want: []int{40, 48, 55, 59}},
+		{name: "CM7", style: Drop24, want: []int{48, 55, 64, 71}},
+		{name: "CM7", style: Spread, want: []int{48, 55, 64, 71}},
+		{name: "CM7", style: Shell, want: []int{48, 52, 59}},
+		{name: "Dm7", style: RootlessA, want: []int{41, 45, 48, 52}},
+		{name: "Dm7", style: RootlessB, want: []int{48, 52, 53, 57}},
+		{name: "G7", style: RootlessA, want: []int{47, 50, 53, 57}},
+		{name: "G7(b9)", style: RootlessB, want: []int{41, 44, 47, 50}},
+		{name: "C", style: Close, want: []int{48, 52, 55}},
+		{name: "C", style: Drop2, want: []int{52, 60, 67}},
+		{name: "C/E", style: Close, want: []int{40, 43, 48}},
+		{name: "C/D", style: Close, want: []int{38, 48, 52, 55}},
+		{name: "Am7/G", style: Shell, want: []int{31, 33, 36, 43}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" "+string(test.style), func(t *testing.T) {
+			actual, err := Voice(test.name, test.style, Range{Low: 0, High: 127})
+			if err != nil {
+				t.Fatalf(`Voice("%v", %v) got error: %v`, test.name, test.style, err)
+			}
+			// put it on the octave of C3 to compare the shape
+			actual = place(actual, test.want[0])
+			if !reflect.DeepEqual(actual, test.want) {
+				t.Errorf(`Voice("%v", %v), actual:"%v", want:"%v"`, test.name, test.style, actual, test.want)
+			}
+		})
+	}
+}
+
+func TestVoiceInRange(t *testing.T) {
+	actual, err := Voice("CM7", Close, DefaultRange)
+	if err != nil || !reflect.DeepEqual(actual, []int{48, 52, 55, 59}) {
+		t.Errorf(`Voice("CM7", close), actual:"%v", err:"%v"`, actual, err)
+	}
+
+	actual, err = Voice("EbM7", Drop2, Range{Low: 50, High: 80})
+	if err != nil || !reflect.DeepEqual(actual, []int{58, 63, 67, 74}) {
+		t.Errorf(`Voice("EbM7", drop2), actual:"%v", err:"%v"`, actual, err)
+	}
+
+	actual, err = Voice("EbM7", Drop2, Range{Low: 50, High: 70})
+	if !errors.Is(err, ErrOutOfRegister) {
+		t.Errorf(`Voice("EbM7", drop2) in 50-70 wants Error(%v). but got "%v", "%v"`, ErrOutOfRegister, actual, err)
+	}
+
+	actual, err = Voice("G", Close, Range{Low: 120, High: note.MaximumNoteNumber})
+	if !errors.Is(err, ErrOutOfRegister) {
+		t.Errorf(`Voice("G") over MaximumNoteNumber wants Error(%v). but got "%v", "%v"`, ErrOutOfRegister, actual, err)
+	}
+}
+
+func TestVoiceError(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		r     Range
+		want  error
+	}{
+		{name: "C", style: Drop3, r: DefaultRange, want: ErrCouldNotVoice},
+		{name: "C", style: Shell, r: DefaultRange, want: ErrCouldNotVoice},
+		{name: "C", style: "cluster", r: DefaultRange, want: ErrInvalidStyle},
+		{name: "C", style: Close, r: Range{Low: 60, High: 50}, want: ErrInvalidRange},
+		{name: "C", style: Close, r: Range{Low: 0, High: 128}, want: ErrInvalidRange},
+		{name: "C13", style: Close, r: Range{Low: 60, High: 66}, want: ErrOutOfRegister},
+		{name: "CN7", style: Close, r: DefaultRange, want: chord.ErrNotFoundKind},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" "+string(test.style), func(t *testing.T) {
+			_, err := Voice(test.name, test.style, test.r)
+			if !errors.Is(err, test.want) {
+				t.Errorf(`Voice("%v", %v, %v) wants Error(%v). but got "%v"`, test.name, test.style, test.r, test.want, err)
+			}
+		})
+	}
+}
+
+func TestPositions(t *testing.T) {
+	c, _ := chord.Parse("C")
+	actual, err := Positions(c, Close, Range{Low: 48, High: 80})
+	want := [][]int{{48, 52, 55}, {60, 64, 67}, {72, 76, 79}}
+	if err != nil || !reflect.DeepEqual(actual, want) {
+		t.Errorf(`Positions("C"), actual:"%v", want:"%v", err:"%v"`, actual, want, err)
+	}
+}